@@ -1,14 +1,74 @@
 package main
 
 import (
+	"encoding/hex"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"log"
 	"os"
+	"runtime/debug"
+	"time"
 
+	"github.com/thehorizonholding/HorizCoin/internal/api"
+	"github.com/thehorizonholding/HorizCoin/internal/config"
+	"github.com/thehorizonholding/HorizCoin/internal/genesis"
+	"github.com/thehorizonholding/HorizCoin/internal/metrics"
+	"github.com/thehorizonholding/HorizCoin/internal/orchestrator"
+	"github.com/thehorizonholding/HorizCoin/internal/p2p"
+	"github.com/thehorizonholding/HorizCoin/internal/store"
+	"github.com/thehorizonholding/HorizCoin/internal/telemetry"
 	"github.com/thehorizonholding/HorizCoin/internal/version"
+	"github.com/thehorizonholding/HorizCoin/pkg/oracle"
+	"github.com/thehorizonholding/HorizCoin/pkg/wallet"
+	"golang.org/x/term"
 )
 
 func main() {
-	args := os.Args[1:]
+	reporter := newReporter()
+	defer reportCrashAndRepanic(reporter)
+
+	dispatch(os.Args[1:], reporter)
+
+	reporter.Close()
+}
+
+// newReporter builds the process-wide telemetry Reporter from env vars.
+// If the disk queue can't be created (e.g. an unwritable directory),
+// telemetry falls back to a no-op sink rather than blocking startup.
+func newReporter() *telemetry.Reporter {
+	r, err := telemetry.NewReporter(telemetry.ConfigFromEnv(), telemetry.SinkFromEnv())
+	if err != nil {
+		log.Printf("telemetry: disabled: %v", err)
+		r, err = telemetry.NewReporter(telemetry.Config{
+			Dir:           os.TempDir(),
+			MaxDiskFiles:  1,
+			MaxDiskSizeMB: 1,
+			QueueSize:     1,
+		}, telemetry.NoopSink{})
+		if err != nil {
+			log.Fatalf("telemetry: could not even start a no-op reporter: %v", err)
+		}
+	}
+	return r
+}
+
+// reportCrashAndRepanic captures a panic's goroutine stack as a crash
+// event so operator visibility does not depend on stderr capture, then
+// re-panics so the process still exits non-zero as before.
+func reportCrashAndRepanic(reporter *telemetry.Reporter) {
+	if rec := recover(); rec != nil {
+		reporter.Report(telemetry.Event{
+			Kind:   "crash",
+			Fields: map[string]string{"panic": fmt.Sprint(rec)},
+			Stack:  string(debug.Stack()),
+		})
+		reporter.Close()
+		panic(rec)
+	}
+}
+
+func dispatch(args []string, reporter *telemetry.Reporter) {
 	if len(args) == 0 {
 		printHelp()
 		return
@@ -18,7 +78,15 @@ func main() {
 	case "version":
 		fmt.Println(version.String())
 	case "demo":
-		runDemo()
+		runDemo(reporter)
+	case "node":
+		runNode(args[1:], reporter)
+	case "genesis":
+		runGenesis(args[1:])
+	case "serve":
+		runServe(args[1:], reporter)
+	case "keys":
+		runKeys(args[1:])
 	default:
 		fmt.Printf("unknown command: %s\n\n", args[0])
 		printHelp()
@@ -30,13 +98,272 @@ func printHelp() {
 	fmt.Println("Usage: horizcoin <command>")
 	fmt.Println("\nCommands:")
 	fmt.Println("  demo       run a short demonstration simulation")
+	fmt.Println("  node       run the node's v1 REST/JSON-RPC API")
+	fmt.Println("  genesis    validate, hash, or show a genesis document")
+	fmt.Println("  serve      run every endpoint enabled in horizcoin.yaml")
+	fmt.Println("  keys       add, list, show, delete, or update keys in the wallet")
 	fmt.Println("  version    print version info")
 }
 
-func runDemo() {
+// runKeys dispatches the "keys {add,list,show,delete,update}"
+// subcommands against the wallet rooted at ~/.horizcoin/keys.
+func runKeys(args []string) {
+	if len(args) < 1 {
+		fmt.Println("Usage: horizcoin keys {add,list,show,delete,update} ...")
+		os.Exit(1)
+	}
+
+	dir, err := wallet.DefaultDir()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	w, err := wallet.New(dir)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	sub := args[0]
+	rest := args[1:]
+
+	switch sub {
+	case "add":
+		fs := flag.NewFlagSet("keys add", flag.ExitOnError)
+		keyType := fs.String("type", string(wallet.SchemeEd25519), "key scheme: ed25519 or secp256k1")
+		fs.Parse(rest)
+		if fs.NArg() < 1 {
+			fmt.Println("Usage: horizcoin keys add --type <ed25519|secp256k1> <name>")
+			os.Exit(1)
+		}
+		name := fs.Arg(0)
+
+		pass, err := readPassphrase("Enter passphrase: ")
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		info, err := w.NewKey(name, wallet.Scheme(*keyType), pass)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		printKeyInfo(info)
+
+	case "list":
+		infos, err := w.List()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		for _, info := range infos {
+			printKeyInfo(info)
+		}
+
+	case "show":
+		if len(rest) < 1 {
+			fmt.Println("Usage: horizcoin keys show <name>")
+			os.Exit(1)
+		}
+		info, err := w.Show(rest[0])
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		printKeyInfo(info)
+
+	case "delete":
+		if len(rest) < 1 {
+			fmt.Println("Usage: horizcoin keys delete <name>")
+			os.Exit(1)
+		}
+		if err := w.Delete(rest[0]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+	case "update":
+		if len(rest) < 1 {
+			fmt.Println("Usage: horizcoin keys update <name>")
+			os.Exit(1)
+		}
+		oldPass, err := readPassphrase("Enter current passphrase: ")
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		newPass, err := readPassphrase("Enter new passphrase: ")
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		if err := w.Update(rest[0], oldPass, newPass); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+	default:
+		fmt.Printf("unknown keys subcommand: %s\n", sub)
+		fmt.Println("Usage: horizcoin keys {add,list,show,delete,update} ...")
+		os.Exit(1)
+	}
+}
+
+func printKeyInfo(info wallet.KeyInfo) {
+	fmt.Printf("%s\t%s\t%s\n", info.Name, info.Scheme, hex.EncodeToString(info.PubKey))
+}
+
+func readPassphrase(prompt string) (string, error) {
+	fmt.Fprint(os.Stderr, prompt)
+	data, err := term.ReadPassword(int(os.Stdin.Fd()))
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", fmt.Errorf("reading passphrase: %w", err)
+	}
+	return string(data), nil
+}
+
+// runServe loads horizcoin.yaml and runs an Orchestrator with
+// whichever endpoints it enables.
+func runServe(args []string, reporter *telemetry.Reporter) {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	configPath := fs.String("config", "horizcoin.yaml", "path to horizcoin.yaml")
+	fs.Parse(args)
+
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	var endpoints []orchestrator.Endpoint
+	for _, name := range cfg.Enabled() {
+		ep := cfg.Endpoints[name]
+		switch name {
+		case "node":
+			endpoints = append(endpoints, api.NewServer(ep.Addr, store.NewDemoStore(10), api.NodeInfo{
+				Moniker: "horizcoin-node",
+				ChainID: "horizcoin-demo",
+			}, reporter))
+		case "oracle":
+			interval := time.Duration(ep.EpochIntervalSeconds) * time.Second
+			if interval <= 0 {
+				interval = 15 * time.Second
+			}
+
+			if ep.SignerKey == "" {
+				oracleServer, pub, err := oracle.NewServerWithGeneratedKey(ep.Addr, interval, reporter)
+				if err != nil {
+					fmt.Fprintln(os.Stderr, err)
+					os.Exit(1)
+				}
+				log.Printf("oracle signing pubkey: %x", pub)
+				endpoints = append(endpoints, oracleServer)
+				continue
+			}
+
+			dir, err := wallet.DefaultDir()
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+			w, err := wallet.New(dir)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+			passphrase := os.Getenv("HORIZCOIN_ORACLE_KEY_PASSPHRASE")
+			signer, err := w.Signer(ep.SignerKey, passphrase)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+			endpoints = append(endpoints, oracle.NewServer(ep.Addr, interval, signer, reporter))
+		case "metrics":
+			endpoints = append(endpoints, metrics.NewServer(ep.Addr, reporter))
+		case "p2p":
+			endpoints = append(endpoints, p2p.NewServer(ep.Addr))
+		default:
+			fmt.Fprintf(os.Stderr, "serve: unknown endpoint %q in config, skipping\n", name)
+		}
+	}
+
+	if len(endpoints) == 0 {
+		fmt.Fprintln(os.Stderr, "serve: no endpoints enabled in config, nothing to run")
+		os.Exit(1)
+	}
+
+	orchestrator.RunUntilSignal(orchestrator.New(10*time.Second, reporter, endpoints...))
+}
+
+// runGenesis dispatches the "genesis {validate,hash,show}" subcommands,
+// each of which takes a single genesis file path argument.
+func runGenesis(args []string) {
+	if len(args) < 2 {
+		fmt.Println("Usage: horizcoin genesis {validate,hash,show} <path>")
+		os.Exit(1)
+	}
+
+	sub, path := args[0], args[1]
+
+	doc, err := genesis.GenesisDocFromFile(path)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	switch sub {
+	case "validate":
+		fmt.Printf("genesis file %s is valid (chain_id=%s, validators=%d)\n", path, doc.ChainID, len(doc.Validators))
+	case "hash":
+		hash, err := doc.ValidatorHashHex()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		fmt.Println(hash)
+	case "show":
+		out, err := json.MarshalIndent(doc, "", "  ")
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		fmt.Println(string(out))
+	default:
+		fmt.Printf("unknown genesis subcommand: %s\n", sub)
+		fmt.Println("Usage: horizcoin genesis {validate,hash,show} <path>")
+		os.Exit(1)
+	}
+}
+
+// runNode starts a long-lived process exposing the node's /v1/ HTTP
+// API. It currently backs the API with an in-memory demo store; a real
+// chain implementation will satisfy the same store.Store interface. It
+// runs through the same orchestrator/httpserver.Base path as "serve"'s
+// "node" endpoint, so it gets graceful shutdown on SIGINT/SIGTERM and
+// crash reporting instead of duplicating that logic with a standalone
+// http.ListenAndServe.
+func runNode(_ []string, reporter *telemetry.Reporter) {
+	addr := ":26657"
+	server := api.NewServer(addr, store.NewDemoStore(10), api.NodeInfo{
+		Moniker: "horizcoin-node",
+		ChainID: "horizcoin-demo",
+	}, reporter)
+
+	log.Printf("HorizCoin node API listening on %s", addr)
+	orchestrator.RunUntilSignal(orchestrator.New(10*time.Second, reporter, server))
+}
+
+func runDemo(reporter *telemetry.Reporter) {
 	fmt.Println("Starting HorizCoin demo simulation...")
 	for i := 1; i <= 3; i++ {
 		fmt.Printf("  mining block %d... ok\n", i)
 	}
-	fmt.Println("Simulation complete. (Telemetry capture hooks TBD)")
+	fmt.Println("Simulation complete.")
+
+	reporter.Report(telemetry.Event{
+		Kind:   "demo_complete",
+		Fields: map[string]string{"blocks_mined": "3"},
+	})
 }
@@ -0,0 +1,199 @@
+// Package api implements the node's versioned HTTP surface under
+// /v1/, modeled on the Cosmos LCD pattern: a thin JSON layer over a
+// store.Store, with uniform error envelopes and per-endpoint method
+// gating.
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/thehorizonholding/HorizCoin/internal/store"
+	"github.com/thehorizonholding/HorizCoin/internal/version"
+)
+
+// NodeInfo describes the running node for /v1/status.
+type NodeInfo struct {
+	Moniker string
+	ChainID string
+}
+
+// errorEnvelope is the uniform shape returned for any handler failure.
+type errorEnvelope struct {
+	Error   bool   `json:"error"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// NewRouter builds the /v1/ mux for the given store and node info.
+func NewRouter(st store.Store, info NodeInfo) *http.ServeMux {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/v1/status", withMethod(http.MethodGet, handleStatus(st, info)))
+	mux.HandleFunc("/v1/version", withMethod(http.MethodGet, handleVersion))
+	mux.HandleFunc("/v1/blocks/latest", withMethod(http.MethodGet, handleLatestBlock(st)))
+	mux.HandleFunc("/v1/blocks/", withMethod(http.MethodGet, handleBlockByHeight(st)))
+	mux.HandleFunc("/v1/validators/", withMethod(http.MethodGet, handleValidators(st)))
+	mux.HandleFunc("/v1/txs", withMethod(http.MethodGet, handleSearchTxs(st)))
+	mux.HandleFunc("/v1/txs/", withMethod(http.MethodGet, handleTxByHash(st)))
+
+	return mux
+}
+
+// withMethod rejects requests that do not use method before calling
+// next, so every handler below can assume its method has already been
+// checked.
+func withMethod(method string, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != method {
+			writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "method "+r.Method+" is not allowed on this endpoint")
+			return
+		}
+		next(w, r)
+	}
+}
+
+func handleStatus(st store.Store, info NodeInfo) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, struct {
+			Moniker      string `json:"moniker"`
+			ChainID      string `json:"chain_id"`
+			LatestHeight int64  `json:"latest_height"`
+			CatchingUp   bool   `json:"catching_up"`
+		}{
+			Moniker:      info.Moniker,
+			ChainID:      info.ChainID,
+			LatestHeight: st.LatestHeight(),
+			// The demo store and the simulation it backs never fall
+			// behind, so catching up is always false for now; a real
+			// chain implementation will report actual sync state here.
+			CatchingUp: false,
+		})
+	}
+}
+
+func handleVersion(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, version.Current())
+}
+
+func handleLatestBlock(st store.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		block, err := st.BlockByHeight(st.LatestHeight())
+		if err != nil {
+			writeError(w, http.StatusNotFound, "no_blocks", "no blocks have been produced yet")
+			return
+		}
+		writeJSON(w, http.StatusOK, block)
+	}
+}
+
+func handleBlockByHeight(st store.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		height, err := parseTrailingInt(r.URL.Path, "/v1/blocks/")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid_height", "height must be a non-negative integer")
+			return
+		}
+		block, err := st.BlockByHeight(height)
+		if errors.Is(err, store.ErrNotFound) {
+			writeError(w, http.StatusNotFound, "block_not_found", "no block at that height")
+			return
+		}
+		writeJSON(w, http.StatusOK, block)
+	}
+}
+
+func handleValidators(st store.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		height, err := parseTrailingInt(r.URL.Path, "/v1/validators/")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid_height", "height must be a non-negative integer")
+			return
+		}
+		validators, err := st.ValidatorsByHeight(height)
+		if errors.Is(err, store.ErrNotFound) {
+			writeError(w, http.StatusNotFound, "validators_not_found", "no validator set at that height")
+			return
+		}
+		writeJSON(w, http.StatusOK, struct {
+			Validators []*store.Validator `json:"validators"`
+		}{Validators: validators})
+	}
+}
+
+func handleTxByHash(st store.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		hash := strings.TrimPrefix(r.URL.Path, "/v1/txs/")
+		if hash == "" {
+			writeError(w, http.StatusBadRequest, "invalid_hash", "tx hash must not be empty")
+			return
+		}
+		tx, err := st.TxByHash(hash)
+		if errors.Is(err, store.ErrNotFound) {
+			writeError(w, http.StatusNotFound, "tx_not_found", "no transaction with that hash")
+			return
+		}
+		writeJSON(w, http.StatusOK, tx)
+	}
+}
+
+func handleSearchTxs(st store.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		tag := q.Get("tag")
+		if tag == "" {
+			writeError(w, http.StatusBadRequest, "missing_tag", "tag query param is required")
+			return
+		}
+
+		page := 1
+		if v := q.Get("page"); v != "" {
+			p, err := strconv.Atoi(v)
+			if err != nil || p < 1 {
+				writeError(w, http.StatusBadRequest, "invalid_page", "page must be a positive integer")
+				return
+			}
+			page = p
+		}
+
+		perPage := 30
+		if v := q.Get("per_page"); v != "" {
+			pp, err := strconv.Atoi(v)
+			if err != nil || pp < 1 {
+				writeError(w, http.StatusBadRequest, "invalid_per_page", "per_page must be a positive integer")
+				return
+			}
+			perPage = pp
+		}
+
+		txs, total, err := st.SearchTxs(tag, page, perPage)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "search_failed", err.Error())
+			return
+		}
+
+		writeJSON(w, http.StatusOK, struct {
+			Txs        []*store.Tx `json:"txs"`
+			TotalCount int         `json:"total_count"`
+			Page       int         `json:"page"`
+			PerPage    int         `json:"per_page"`
+		}{Txs: txs, TotalCount: total, Page: page, PerPage: perPage})
+	}
+}
+
+func parseTrailingInt(path, prefix string) (int64, error) {
+	return strconv.ParseInt(strings.TrimPrefix(path, prefix), 10, 64)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, errorEnvelope{Error: true, Code: code, Message: message})
+}
@@ -0,0 +1,119 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/thehorizonholding/HorizCoin/internal/store"
+)
+
+// fakeStore is a minimal store.Store a test can drive directly,
+// without depending on DemoStore's synthetic data.
+type fakeStore struct {
+	latest     int64
+	blocks     map[int64]*store.Block
+	validators map[int64][]*store.Validator
+	txs        map[string]*store.Tx
+}
+
+func (f *fakeStore) LatestHeight() int64 { return f.latest }
+
+func (f *fakeStore) BlockByHeight(height int64) (*store.Block, error) {
+	b, ok := f.blocks[height]
+	if !ok {
+		return nil, store.ErrNotFound
+	}
+	return b, nil
+}
+
+func (f *fakeStore) ValidatorsByHeight(height int64) ([]*store.Validator, error) {
+	v, ok := f.validators[height]
+	if !ok {
+		return nil, store.ErrNotFound
+	}
+	return v, nil
+}
+
+func (f *fakeStore) TxByHash(hash string) (*store.Tx, error) {
+	tx, ok := f.txs[hash]
+	if !ok {
+		return nil, store.ErrNotFound
+	}
+	return tx, nil
+}
+
+func (f *fakeStore) SearchTxs(tag string, page, perPage int) ([]*store.Tx, int, error) {
+	return nil, 0, nil
+}
+
+func TestHandleStatus(t *testing.T) {
+	st := &fakeStore{latest: 42}
+	mux := NewRouter(st, NodeInfo{Moniker: "test-node", ChainID: "test-chain"})
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/status", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	var body struct {
+		Moniker      string `json:"moniker"`
+		ChainID      string `json:"chain_id"`
+		LatestHeight int64  `json:"latest_height"`
+	}
+	if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+	if body.Moniker != "test-node" || body.ChainID != "test-chain" || body.LatestHeight != 42 {
+		t.Errorf("unexpected body: %+v", body)
+	}
+}
+
+func TestHandleBlockByHeightNotFound(t *testing.T) {
+	st := &fakeStore{blocks: map[int64]*store.Block{}}
+	mux := NewRouter(st, NodeInfo{})
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/blocks/7", nil))
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+func TestHandleBlockByHeightFound(t *testing.T) {
+	st := &fakeStore{blocks: map[int64]*store.Block{
+		7: {Height: 7, Hash: "deadbeef", NumTxs: 3},
+	}}
+	mux := NewRouter(st, NodeInfo{})
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/blocks/7", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	var block store.Block
+	if err := json.NewDecoder(rec.Body).Decode(&block); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+	if block.Hash != "deadbeef" {
+		t.Errorf("Hash = %q, want %q", block.Hash, "deadbeef")
+	}
+}
+
+func TestHandleRejectsWrongMethod(t *testing.T) {
+	st := &fakeStore{}
+	mux := NewRouter(st, NodeInfo{})
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/status", nil))
+
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusMethodNotAllowed)
+	}
+}
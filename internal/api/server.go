@@ -0,0 +1,21 @@
+package api
+
+import (
+	"github.com/thehorizonholding/HorizCoin/internal/httpserver"
+	"github.com/thehorizonholding/HorizCoin/internal/store"
+	"github.com/thehorizonholding/HorizCoin/internal/telemetry"
+)
+
+// Server runs the /v1/ API as an orchestrator.Endpoint, on top of the
+// same router NewRouter builds for standalone use.
+type Server struct {
+	*httpserver.Base
+}
+
+// NewServer returns a node API Server listening on addr, backed by st.
+// reporter receives a crash event if the server's goroutine panics.
+func NewServer(addr string, st store.Store, info NodeInfo, reporter *telemetry.Reporter) *Server {
+	return &Server{Base: httpserver.NewBase(addr, NewRouter(st, info), reporter)}
+}
+
+func (s *Server) Name() string { return "node" }
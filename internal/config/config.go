@@ -0,0 +1,99 @@
+// Package config loads horizcoin.yaml, the single file that configures
+// which endpoints the orchestrator starts and how each of them is
+// addressed.
+package config
+
+import (
+	"fmt"
+	"os"
+)
+
+// EndpointConfig is the per-endpoint section of horizcoin.yaml. Not
+// every field is meaningful to every endpoint; each endpoint
+// constructor reads the fields it needs and ignores the rest.
+type EndpointConfig struct {
+	Enabled              bool
+	Addr                 string
+	EpochIntervalSeconds int
+	// SignerKey, if set, names the pkg/wallet key that signs this
+	// endpoint's output (currently only meaningful for "oracle"); if
+	// empty the endpoint falls back to a freshly generated key.
+	SignerKey string
+}
+
+// Config is the parsed form of horizcoin.yaml.
+type Config struct {
+	Endpoints map[string]EndpointConfig
+	// order records the order endpoints were declared in
+	// horizcoin.yaml, so Enabled can start them in that order instead
+	// of an arbitrary one.
+	order []string
+}
+
+// Enabled returns the names of endpoints marked enabled, in the order
+// they were declared in horizcoin.yaml, so callers get the start order
+// the config file actually asked for.
+func (c Config) Enabled() []string {
+	var names []string
+	for _, name := range c.order {
+		if c.Endpoints[name].Enabled {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// Load reads and parses horizcoin.yaml at path.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("config: reading %s: %w", path, err)
+	}
+
+	tree, err := parseYAML(data)
+	if err != nil {
+		return nil, fmt.Errorf("config: parsing %s: %w", path, err)
+	}
+
+	cfg := &Config{Endpoints: make(map[string]EndpointConfig)}
+
+	rawEndpointsVal, _ := tree.get("endpoints")
+	rawEndpoints, _ := rawEndpointsVal.(*orderedMap)
+	if rawEndpoints == nil {
+		return cfg, nil
+	}
+	for _, name := range rawEndpoints.keys {
+		rawVal, _ := rawEndpoints.get(name)
+		rawEp, ok := rawVal.(*orderedMap)
+		if !ok {
+			return nil, fmt.Errorf("config: endpoints.%s must be a mapping", name)
+		}
+
+		ep := EndpointConfig{}
+		if v, ok := rawEp.get("enabled"); ok {
+			if b, ok := v.(bool); ok {
+				ep.Enabled = b
+			}
+		}
+		if v, ok := rawEp.get("addr"); ok {
+			if s, ok := v.(string); ok {
+				ep.Addr = s
+			}
+		}
+		if v, ok := rawEp.get("epoch_interval_seconds"); ok {
+			if n, ok := v.(int); ok {
+				ep.EpochIntervalSeconds = n
+			}
+		}
+		if v, ok := rawEp.get("signer_key"); ok {
+			if s, ok := v.(string); ok {
+				ep.SignerKey = s
+			}
+		}
+
+		cfg.Endpoints[name] = ep
+		cfg.order = append(cfg.order, name)
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,128 @@
+package config
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// orderedMap is a mapping that remembers the order keys were first set
+// in, so callers that care about declaration order (Config.Enabled)
+// don't have to fall back to sorting alphabetically.
+type orderedMap struct {
+	keys   []string
+	values map[string]interface{}
+}
+
+func newOrderedMap() *orderedMap {
+	return &orderedMap{values: map[string]interface{}{}}
+}
+
+func (m *orderedMap) set(key string, val interface{}) {
+	if _, exists := m.values[key]; !exists {
+		m.keys = append(m.keys, key)
+	}
+	m.values[key] = val
+}
+
+func (m *orderedMap) get(key string) (interface{}, bool) {
+	v, ok := m.values[key]
+	return v, ok
+}
+
+// parseYAML implements the small subset of YAML horizcoin.yaml
+// actually needs: nested mappings built from "key: value" lines
+// indented with spaces, "#" comments, and scalar values typed as bool,
+// int, or string. There is no external YAML dependency vendored into
+// this module yet, so this stands in until one is; it intentionally
+// does not attempt lists, multi-line strings, or flow style. Mappings
+// preserve the order keys first appear in, matching how the rest of
+// YAML (and this repo's config) treats declaration order as
+// meaningful.
+func parseYAML(data []byte) (*orderedMap, error) {
+	type frame struct {
+		indent int
+		node   *orderedMap
+	}
+
+	root := newOrderedMap()
+	stack := []frame{{indent: -1, node: root}}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		raw := scanner.Text()
+
+		line := stripComment(raw)
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		indent := countIndent(line)
+		trimmed := strings.TrimSpace(line)
+
+		colon := strings.Index(trimmed, ":")
+		if colon < 0 {
+			return nil, fmt.Errorf("line %d: expected \"key: value\", got %q", lineNo, trimmed)
+		}
+		key := strings.TrimSpace(trimmed[:colon])
+		val := strings.TrimSpace(trimmed[colon+1:])
+
+		for len(stack) > 1 && indent <= stack[len(stack)-1].indent {
+			stack = stack[:len(stack)-1]
+		}
+		parent := stack[len(stack)-1].node
+
+		if val == "" {
+			child := newOrderedMap()
+			parent.set(key, child)
+			stack = append(stack, frame{indent: indent, node: child})
+			continue
+		}
+
+		parent.set(key, parseScalar(val))
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return root, nil
+}
+
+func stripComment(line string) string {
+	if idx := strings.Index(line, "#"); idx >= 0 {
+		return line[:idx]
+	}
+	return line
+}
+
+func countIndent(line string) int {
+	n := 0
+	for _, r := range line {
+		if r != ' ' {
+			break
+		}
+		n++
+	}
+	return n
+}
+
+func parseScalar(val string) interface{} {
+	if len(val) >= 2 && val[0] == '"' && val[len(val)-1] == '"' {
+		return val[1 : len(val)-1]
+	}
+	switch val {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+	if n, err := strconv.Atoi(val); err == nil {
+		return n
+	}
+	return val
+}
@@ -0,0 +1,151 @@
+// Package genesis loads and validates a chain's genesis document: the
+// chain ID, genesis time, consensus parameters, and initial validator
+// set that every node must agree on before a network can start.
+package genesis
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/thehorizonholding/HorizCoin/pkg/merkle"
+)
+
+// ConsensusParams bounds the block production parameters agreed on at
+// genesis.
+type ConsensusParams struct {
+	MaxBlockBytes int64 `json:"max_block_bytes"`
+	MaxGasPerTx   int64 `json:"max_gas_per_tx"`
+}
+
+// Validator is a single entry in the genesis validator set.
+type Validator struct {
+	Address     string `json:"address"`
+	PubKeyType  string `json:"pub_key_type"`
+	PubKeyBytes []byte `json:"pub_key_bytes"`
+	VotingPower int64  `json:"voting_power"`
+}
+
+// GenesisDoc is the full genesis document a network bootstraps from.
+type GenesisDoc struct {
+	ChainID         string          `json:"chain_id"`
+	GenesisTime     int64           `json:"genesis_time"`
+	ConsensusParams ConsensusParams `json:"consensus_params"`
+	Validators      []Validator     `json:"validators"`
+	AppState        json.RawMessage `json:"app_state"`
+}
+
+// GenesisDocFromFile reads and strictly validates a genesis document
+// from path: unknown JSON fields are rejected, every validator address
+// must be non-empty with positive voting power, and ChainID must be
+// set.
+func GenesisDocFromFile(path string) (*GenesisDoc, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("genesis: reading %s: %w", path, err)
+	}
+
+	var doc GenesisDoc
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("genesis: parsing %s: %w", path, err)
+	}
+
+	if err := doc.Validate(); err != nil {
+		return nil, fmt.Errorf("genesis: %s: %w", path, err)
+	}
+
+	return &doc, nil
+}
+
+// Validate checks the document's required fields and invariants.
+func (g *GenesisDoc) Validate() error {
+	if g.ChainID == "" {
+		return fmt.Errorf("chain_id must not be empty")
+	}
+	if len(g.Validators) == 0 {
+		return fmt.Errorf("validators must not be empty")
+	}
+
+	for i, v := range g.Validators {
+		if v.Address == "" {
+			return fmt.Errorf("validator %d: address must not be empty", i)
+		}
+		if v.VotingPower <= 0 {
+			return fmt.Errorf("validator %d (%s): voting_power must be > 0", i, v.Address)
+		}
+	}
+
+	return nil
+}
+
+// validatorLeaf serializes a validator the same way on every call,
+// length-prefixing every variable-length field (as pkg/oracle's
+// NodeAttestation.Canonical does) so two different validator sets
+// never serialize to the same bytes: address, pubkey_type, and
+// pubkey_bytes could otherwise shift across field boundaries and
+// collide, e.g. {"ab","x","yz"} and {"a","bx","yz"} concatenate
+// identically without length prefixes.
+func validatorLeaf(v Validator) []byte {
+	var buf bytes.Buffer
+	writeString(&buf, v.Address)
+	writeString(&buf, v.PubKeyType)
+	writeBytes(&buf, v.PubKeyBytes)
+	writeUint64(&buf, uint64(v.VotingPower))
+	return buf.Bytes()
+}
+
+func writeString(buf *bytes.Buffer, s string) {
+	writeUint64(buf, uint64(len(s)))
+	buf.WriteString(s)
+}
+
+func writeBytes(buf *bytes.Buffer, b []byte) {
+	writeUint64(buf, uint64(len(b)))
+	buf.Write(b)
+}
+
+func writeUint64(buf *bytes.Buffer, v uint64) {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], v)
+	buf.Write(b[:])
+}
+
+// ValidatorHash returns a deterministic Merkle root over the initial
+// validator set: validators are sorted by address bytes, serialized
+// with validatorLeaf, and committed with the same binary Merkle tree
+// scheme used for oracle epoch reports (pkg/merkle).
+func (g *GenesisDoc) ValidatorHash() ([]byte, error) {
+	sorted := make([]Validator, len(g.Validators))
+	copy(sorted, g.Validators)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Address < sorted[j].Address
+	})
+
+	leaves := make([][]byte, len(sorted))
+	for i, v := range sorted {
+		leaves[i] = validatorLeaf(v)
+	}
+
+	tree, err := merkle.NewFromData(leaves)
+	if err != nil {
+		return nil, fmt.Errorf("genesis: building validator hash: %w", err)
+	}
+
+	return tree.Root(), nil
+}
+
+// ValidatorHashHex is a convenience wrapper returning ValidatorHash as
+// a lowercase hex string, for CLI output.
+func (g *GenesisDoc) ValidatorHashHex() (string, error) {
+	root, err := g.ValidatorHash()
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(root), nil
+}
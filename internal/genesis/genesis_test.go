@@ -0,0 +1,111 @@
+package genesis
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeTempGenesis(t *testing.T, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "genesis.json")
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatalf("writing temp genesis: %v", err)
+	}
+	return path
+}
+
+func TestGenesisDocFromFileRejectsUnknownFields(t *testing.T) {
+	path := writeTempGenesis(t, `{
+		"chain_id": "test-chain",
+		"validators": [{"address": "a", "pub_key_type": "ed25519", "pub_key_bytes": "AA==", "voting_power": 1}],
+		"unexpected_field": true
+	}`)
+
+	if _, err := GenesisDocFromFile(path); err == nil {
+		t.Error("GenesisDocFromFile accepted an unknown field")
+	}
+}
+
+func TestValidateRejectsEmptyChainID(t *testing.T) {
+	doc := GenesisDoc{
+		Validators: []Validator{{Address: "a", VotingPower: 1}},
+	}
+	if err := doc.Validate(); err == nil {
+		t.Error("Validate accepted an empty chain_id")
+	}
+}
+
+func TestValidateRejectsEmptyValidators(t *testing.T) {
+	doc := GenesisDoc{ChainID: "test-chain"}
+	if err := doc.Validate(); err == nil {
+		t.Error("Validate accepted an empty validator set")
+	}
+}
+
+func TestValidateRejectsNonPositiveVotingPower(t *testing.T) {
+	for _, power := range []int64{0, -1} {
+		doc := GenesisDoc{
+			ChainID:    "test-chain",
+			Validators: []Validator{{Address: "a", VotingPower: power}},
+		}
+		if err := doc.Validate(); err == nil {
+			t.Errorf("Validate accepted voting_power=%d", power)
+		}
+	}
+}
+
+// TestValidatorHashDistinguishesFieldBoundaries is the fixture a
+// missing length-prefix would have let through: two validator sets
+// whose fields, concatenated without separators, would produce the
+// same bytes ("ab"+"x" == "a"+"bx"), but which must still commit to
+// different hashes.
+func TestValidatorHashDistinguishesFieldBoundaries(t *testing.T) {
+	docA := GenesisDoc{
+		ChainID: "test-chain",
+		Validators: []Validator{
+			{Address: "ab", PubKeyType: "x", PubKeyBytes: []byte("yz"), VotingPower: 1},
+		},
+	}
+	docB := GenesisDoc{
+		ChainID: "test-chain",
+		Validators: []Validator{
+			{Address: "a", PubKeyType: "bx", PubKeyBytes: []byte("yz"), VotingPower: 1},
+		},
+	}
+
+	hashA, err := docA.ValidatorHash()
+	if err != nil {
+		t.Fatalf("docA.ValidatorHash: %v", err)
+	}
+	hashB, err := docB.ValidatorHash()
+	if err != nil {
+		t.Fatalf("docB.ValidatorHash: %v", err)
+	}
+
+	if bytes.Equal(hashA, hashB) {
+		t.Error("ValidatorHash collided across a shifted field boundary")
+	}
+}
+
+func TestValidatorHashIsOrderIndependent(t *testing.T) {
+	v1 := Validator{Address: "validator-1", PubKeyType: "ed25519", PubKeyBytes: []byte("k1"), VotingPower: 10}
+	v2 := Validator{Address: "validator-2", PubKeyType: "ed25519", PubKeyBytes: []byte("k2"), VotingPower: 20}
+
+	docA := GenesisDoc{ChainID: "c", Validators: []Validator{v1, v2}}
+	docB := GenesisDoc{ChainID: "c", Validators: []Validator{v2, v1}}
+
+	hashA, err := docA.ValidatorHash()
+	if err != nil {
+		t.Fatalf("docA.ValidatorHash: %v", err)
+	}
+	hashB, err := docB.ValidatorHash()
+	if err != nil {
+		t.Fatalf("docB.ValidatorHash: %v", err)
+	}
+
+	if !bytes.Equal(hashA, hashB) {
+		t.Error("ValidatorHash depended on validator declaration order")
+	}
+}
@@ -0,0 +1,61 @@
+// Package httpserver holds the orchestrator.Endpoint boilerplate
+// common to every endpoint that is just an *http.Server: serve until
+// ctx is canceled, report readiness, and shut down gracefully on
+// Stop. internal/api, internal/metrics, and pkg/oracle each embed a
+// Base instead of repeating this logic.
+package httpserver
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/thehorizonholding/HorizCoin/internal/telemetry"
+)
+
+// Base runs handler as an HTTP server satisfying orchestrator.Endpoint's
+// Start/Stop/Ready. Embedders add their own Name() and any setup their
+// Start needs before calling Base.Start.
+type Base struct {
+	httpServer *http.Server
+	reporter   *telemetry.Reporter
+	ready      int32
+}
+
+// NewBase returns a Base serving handler on addr, reporting any panic
+// in its ListenAndServe goroutine to reporter before it crashes the
+// process.
+func NewBase(addr string, handler http.Handler, reporter *telemetry.Reporter) *Base {
+	return &Base{
+		httpServer: &http.Server{Addr: addr, Handler: handler},
+		reporter:   reporter,
+	}
+}
+
+// Start serves HTTP until ctx is canceled.
+func (b *Base) Start(ctx context.Context) error {
+	atomic.StoreInt32(&b.ready, 1)
+
+	errCh := make(chan error, 1)
+	go telemetry.Guard(b.reporter, func() { errCh <- b.httpServer.ListenAndServe() })
+
+	select {
+	case <-ctx.Done():
+		return nil
+	case err := <-errCh:
+		if err != nil && err != http.ErrServerClosed {
+			return err
+		}
+		return nil
+	}
+}
+
+// Stop gracefully shuts down the HTTP server within ctx's deadline.
+func (b *Base) Stop(ctx context.Context) error {
+	return b.httpServer.Shutdown(ctx)
+}
+
+// Ready reports whether the server has finished its startup sequence.
+func (b *Base) Ready() bool {
+	return atomic.LoadInt32(&b.ready) == 1
+}
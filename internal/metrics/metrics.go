@@ -0,0 +1,44 @@
+// Package metrics runs a minimal /metrics endpoint as an orchestrator
+// endpoint. It currently reports only process uptime; richer counters
+// will be added as the other subsystems grow something worth
+// measuring.
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/thehorizonholding/HorizCoin/internal/httpserver"
+	"github.com/thehorizonholding/HorizCoin/internal/telemetry"
+)
+
+// Server serves /metrics as an orchestrator.Endpoint.
+type Server struct {
+	*httpserver.Base
+	startedAt time.Time
+}
+
+// NewServer returns a metrics Server listening on addr. reporter
+// receives a crash event if the server's goroutine panics.
+func NewServer(addr string, reporter *telemetry.Reporter) *Server {
+	s := &Server{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", s.handleMetrics)
+	s.Base = httpserver.NewBase(addr, mux, reporter)
+	return s
+}
+
+func (s *Server) Name() string { return "metrics" }
+
+// Start records the uptime baseline before serving HTTP.
+func (s *Server) Start(ctx context.Context) error {
+	s.startedAt = time.Now()
+	return s.Base.Start(ctx)
+}
+
+func (s *Server) handleMetrics(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	fmt.Fprintf(w, "horizcoin_uptime_seconds %.0f\n", time.Since(s.startedAt).Seconds())
+}
@@ -0,0 +1,108 @@
+// Package orchestrator runs a set of long-lived subsystems (the node
+// API, the oracle, metrics, p2p, ...) behind one process, starting them
+// in declared order and shutting all of them down cleanly on SIGINT or
+// SIGTERM.
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/thehorizonholding/HorizCoin/internal/telemetry"
+	"golang.org/x/sync/errgroup"
+)
+
+// Endpoint is a subsystem the Orchestrator can own: the node API, the
+// oracle, a metrics server, a p2p listener, and so on.
+type Endpoint interface {
+	// Name identifies the endpoint in logs and errors.
+	Name() string
+	// Start runs the endpoint until ctx is canceled or it fails on its
+	// own. It must not return nil before ctx is done unless the
+	// endpoint has genuinely finished its work.
+	Start(ctx context.Context) error
+	// Stop asks the endpoint to shut down, blocking until it has
+	// drained or ctx (a bounded deadline) expires.
+	Stop(ctx context.Context) error
+	// Ready reports whether the endpoint has finished starting and is
+	// serving traffic.
+	Ready() bool
+}
+
+// Orchestrator owns a set of endpoints and runs them together,
+// propagating process shutdown signals into a shared context.
+type Orchestrator struct {
+	endpoints     []Endpoint
+	drainDeadline time.Duration
+	reporter      *telemetry.Reporter
+}
+
+// New returns an Orchestrator that starts endpoints in the given order
+// and allows drainDeadline for each to stop once shutdown begins.
+// reporter receives a crash event if any endpoint's Start goroutine
+// panics.
+func New(drainDeadline time.Duration, reporter *telemetry.Reporter, endpoints ...Endpoint) *Orchestrator {
+	return &Orchestrator{
+		endpoints:     endpoints,
+		drainDeadline: drainDeadline,
+		reporter:      reporter,
+	}
+}
+
+// Run starts every endpoint in declared order, blocks until the
+// process receives SIGINT/SIGTERM or an endpoint fails, then stops
+// every endpoint and returns the first error encountered (if any).
+//
+// Endpoints run under errgroup.WithContext so that one endpoint
+// failing cancels the shared context immediately, waking the other
+// endpoints and the drain logic below instead of leaving Run blocked
+// on a signal that will never arrive.
+func (o *Orchestrator) Run(ctx context.Context) error {
+	ctx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	g, gctx := errgroup.WithContext(ctx)
+
+	for _, ep := range o.endpoints {
+		ep := ep
+		g.Go(func() error {
+			var err error
+			telemetry.Guard(o.reporter, func() { err = ep.Start(gctx) })
+			if err != nil && gctx.Err() == nil {
+				return fmt.Errorf("endpoint %s: %w", ep.Name(), err)
+			}
+			return nil
+		})
+	}
+
+	<-gctx.Done()
+
+	drainCtx, cancel := context.WithTimeout(context.Background(), o.drainDeadline)
+	defer cancel()
+
+	var stopErr error
+	for _, ep := range o.endpoints {
+		if err := ep.Stop(drainCtx); err != nil && stopErr == nil {
+			stopErr = fmt.Errorf("stopping endpoint %s: %w", ep.Name(), err)
+		}
+	}
+
+	if err := g.Wait(); err != nil {
+		return err
+	}
+	return stopErr
+}
+
+// RunUntilSignal is a convenience wrapper for callers (cmd/horizcoin)
+// that just want to run until the process is signaled, logging the
+// error on exit.
+func RunUntilSignal(o *Orchestrator) {
+	if err := o.Run(context.Background()); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
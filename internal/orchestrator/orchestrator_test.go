@@ -0,0 +1,125 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thehorizonholding/HorizCoin/internal/telemetry"
+)
+
+func newTestReporter(t *testing.T) *telemetry.Reporter {
+	t.Helper()
+	r, err := telemetry.NewReporter(telemetry.Config{
+		Dir:           t.TempDir(),
+		MaxDiskFiles:  10,
+		MaxDiskSizeMB: 1,
+		QueueSize:     10,
+	}, telemetry.NoopSink{})
+	if err != nil {
+		t.Fatalf("newTestReporter: %v", err)
+	}
+	return r
+}
+
+// fakeEndpoint is an Endpoint a test can drive directly: Start blocks
+// until ctx is done (or returns startErr immediately), and both calls
+// are observable via channels.
+type fakeEndpoint struct {
+	name     string
+	startErr error
+	started  chan struct{}
+	stopped  chan struct{}
+}
+
+func newFakeEndpoint(name string) *fakeEndpoint {
+	return &fakeEndpoint{name: name, started: make(chan struct{}), stopped: make(chan struct{})}
+}
+
+func newFailingFakeEndpoint(name string, err error) *fakeEndpoint {
+	e := newFakeEndpoint(name)
+	e.startErr = err
+	return e
+}
+
+func (e *fakeEndpoint) Name() string { return e.name }
+
+func (e *fakeEndpoint) Start(ctx context.Context) error {
+	close(e.started)
+	if e.startErr != nil {
+		return e.startErr
+	}
+	<-ctx.Done()
+	return nil
+}
+
+func (e *fakeEndpoint) Stop(ctx context.Context) error {
+	close(e.stopped)
+	return nil
+}
+
+func (e *fakeEndpoint) Ready() bool { return true }
+
+func TestRunStopsAllEndpointsOnContextCancel(t *testing.T) {
+	reporter := newTestReporter(t)
+	defer reporter.Close()
+
+	a := newFakeEndpoint("a")
+	b := newFakeEndpoint("b")
+	o := New(time.Second, reporter, a, b)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- o.Run(ctx) }()
+
+	<-a.started
+	<-b.started
+	cancel()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Run() = %v, want nil", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Run did not return after context cancel")
+	}
+
+	for _, e := range []*fakeEndpoint{a, b} {
+		select {
+		case <-e.stopped:
+		default:
+			t.Errorf("endpoint %s was not stopped", e.name)
+		}
+	}
+}
+
+func TestRunCancelsAndStopsOnEndpointFailure(t *testing.T) {
+	reporter := newTestReporter(t)
+	defer reporter.Close()
+
+	failErr := fmt.Errorf("boom")
+	a := newFakeEndpoint("a")
+	b := newFailingFakeEndpoint("b", failErr)
+	o := New(time.Second, reporter, a, b)
+
+	done := make(chan error, 1)
+	go func() { done <- o.Run(context.Background()) }()
+
+	select {
+	case err := <-done:
+		if err == nil || !strings.Contains(err.Error(), "boom") {
+			t.Fatalf("Run() = %v, want an error wrapping %q", err, failErr)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Run did not return after endpoint failure")
+	}
+
+	select {
+	case <-a.stopped:
+	default:
+		t.Error("endpoint a was not stopped after sibling endpoint failed")
+	}
+}
@@ -0,0 +1,46 @@
+// Package p2p will own peer discovery and block/tx gossip. Neither
+// exists yet, so Server is a placeholder orchestrator.Endpoint that
+// holds the config slot open and logs that it is inert; it lets
+// horizcoin.yaml enable "p2p" today without the rest of the
+// orchestrator needing a special case for "not implemented".
+package p2p
+
+import (
+	"context"
+	"log"
+	"sync/atomic"
+)
+
+// Server is a placeholder p2p endpoint: it starts, reports ready, and
+// does nothing until ctx is canceled.
+type Server struct {
+	addr  string
+	ready int32
+}
+
+// NewServer returns a placeholder p2p Server that would listen on addr
+// once peer networking is implemented.
+func NewServer(addr string) *Server {
+	return &Server{addr: addr}
+}
+
+func (s *Server) Name() string { return "p2p" }
+
+// Start logs that p2p is not yet implemented and blocks until ctx is
+// canceled.
+func (s *Server) Start(ctx context.Context) error {
+	log.Printf("p2p: not yet implemented, holding config slot on %s", s.addr)
+	atomic.StoreInt32(&s.ready, 1)
+	<-ctx.Done()
+	return nil
+}
+
+// Stop is a no-op: there is nothing running to drain yet.
+func (s *Server) Stop(ctx context.Context) error {
+	return nil
+}
+
+// Ready reports whether Start has begun holding its slot.
+func (s *Server) Ready() bool {
+	return atomic.LoadInt32(&s.ready) == 1
+}
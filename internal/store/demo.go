@@ -0,0 +1,129 @@
+package store
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// DemoStore is an in-memory Store seeded with a handful of synthetic
+// blocks and transactions. It exists so the API layer and its tests
+// have something to run against before a real chain implementation
+// lands.
+type DemoStore struct {
+	mu         sync.RWMutex
+	blocks     map[int64]*Block
+	txs        map[string]*Tx
+	validators map[int64][]*Validator
+	latest     int64
+}
+
+// NewDemoStore seeds numBlocks synthetic blocks, one transaction each,
+// and a fixed validator set shared across all heights.
+func NewDemoStore(numBlocks int) *DemoStore {
+	s := &DemoStore{
+		blocks:     make(map[int64]*Block),
+		txs:        make(map[string]*Tx),
+		validators: make(map[int64][]*Validator),
+	}
+
+	validators := []*Validator{
+		{Address: "validator-1", VotingPower: 100},
+		{Address: "validator-2", VotingPower: 80},
+	}
+
+	now := time.Now().Unix()
+	for h := int64(1); h <= int64(numBlocks); h++ {
+		hash := fmt.Sprintf("block-hash-%d", h)
+		s.blocks[h] = &Block{
+			Height: h,
+			Hash:   hash,
+			Time:   now - (int64(numBlocks)-h)*5,
+			NumTxs: 1,
+		}
+		s.validators[h] = validators
+
+		txHash := fmt.Sprintf("tx-hash-%d", h)
+		s.txs[txHash] = &Tx{
+			Hash:   txHash,
+			Height: h,
+			Index:  0,
+			Tags:   []string{"demo"},
+			Code:   0,
+		}
+		s.latest = h
+	}
+
+	return s
+}
+
+func (s *DemoStore) LatestHeight() int64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.latest
+}
+
+func (s *DemoStore) BlockByHeight(height int64) (*Block, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	b, ok := s.blocks[height]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return b, nil
+}
+
+func (s *DemoStore) ValidatorsByHeight(height int64) ([]*Validator, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	v, ok := s.validators[height]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return v, nil
+}
+
+func (s *DemoStore) TxByHash(hash string) (*Tx, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	tx, ok := s.txs[hash]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return tx, nil
+}
+
+func (s *DemoStore) SearchTxs(tag string, page, perPage int) ([]*Tx, int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var matches []*Tx
+	for h := int64(1); h <= s.latest; h++ {
+		txHash := fmt.Sprintf("tx-hash-%d", h)
+		tx := s.txs[txHash]
+		for _, t := range tx.Tags {
+			if t == tag {
+				matches = append(matches, tx)
+				break
+			}
+		}
+	}
+
+	total := len(matches)
+	if page < 1 {
+		page = 1
+	}
+	if perPage < 1 {
+		perPage = 30
+	}
+
+	start := (page - 1) * perPage
+	if start >= total {
+		return []*Tx{}, total, nil
+	}
+	end := start + perPage
+	if end > total {
+		end = total
+	}
+	return matches[start:end], total, nil
+}
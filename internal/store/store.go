@@ -0,0 +1,58 @@
+// Package store defines the read interface the node's HTTP API needs
+// from the chain: blocks, transactions, and validator sets by height.
+// The demo simulation and, later, the real chain implementation both
+// satisfy Store, so the API layer and its tests never depend on which
+// one is backing them.
+package store
+
+import "fmt"
+
+// Block is the minimal block data the API surface exposes.
+type Block struct {
+	Height int64  `json:"height"`
+	Hash   string `json:"hash"`
+	Time   int64  `json:"time"`
+	NumTxs int    `json:"num_txs"`
+}
+
+// Tx is a transaction as returned by hash lookups and search.
+type Tx struct {
+	Hash   string   `json:"hash"`
+	Height int64    `json:"height"`
+	Index  int      `json:"index"`
+	Tags   []string `json:"tags"`
+	Code   uint32   `json:"code"`
+}
+
+// Validator is a single entry in a height's validator set.
+type Validator struct {
+	Address     string `json:"address"`
+	VotingPower int64  `json:"voting_power"`
+}
+
+// ErrNotFound is returned by lookups that find nothing at the given
+// key; API handlers translate it to a 404.
+var ErrNotFound = fmt.Errorf("store: not found")
+
+// Store is the read surface the node API needs. Implementations must
+// be safe for concurrent use.
+type Store interface {
+	// LatestHeight returns the height of the most recent block.
+	LatestHeight() int64
+
+	// BlockByHeight returns the block at height, or ErrNotFound if
+	// height is out of range.
+	BlockByHeight(height int64) (*Block, error)
+
+	// ValidatorsByHeight returns the validator set active at height,
+	// or ErrNotFound if height is out of range.
+	ValidatorsByHeight(height int64) ([]*Validator, error)
+
+	// TxByHash returns the transaction with the given hash, or
+	// ErrNotFound if no such transaction exists.
+	TxByHash(hash string) (*Tx, error)
+
+	// SearchTxs returns transactions matching tag, paginated, along
+	// with the total number of matches across all pages.
+	SearchTxs(tag string, page, perPage int) (txs []*Tx, total int, err error)
+}
@@ -0,0 +1,87 @@
+package telemetry
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// diskQueue persists events as content-addressed files under dir,
+// evicting the oldest files first whenever either cap is exceeded.
+type diskQueue struct {
+	dir          string
+	maxFiles     int
+	maxSizeBytes int64
+}
+
+func newDiskQueue(dir string, maxFiles int, maxSizeMB int) (*diskQueue, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("telemetry: creating %s: %w", dir, err)
+	}
+	return &diskQueue{
+		dir:          dir,
+		maxFiles:     maxFiles,
+		maxSizeBytes: int64(maxSizeMB) * 1024 * 1024,
+	}, nil
+}
+
+// Write persists e under a filename derived from its content hash and
+// then enforces the disk caps.
+func (q *diskQueue) Write(e Event) error {
+	hash, data, err := e.contentHash()
+	if err != nil {
+		return fmt.Errorf("telemetry: encoding event: %w", err)
+	}
+
+	path := filepath.Join(q.dir, hash+".json")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("telemetry: writing %s: %w", path, err)
+	}
+
+	return q.enforceCaps()
+}
+
+type queuedFile struct {
+	path    string
+	modTime int64
+	size    int64
+}
+
+func (q *diskQueue) enforceCaps() error {
+	entries, err := os.ReadDir(q.dir)
+	if err != nil {
+		return fmt.Errorf("telemetry: listing %s: %w", q.dir, err)
+	}
+
+	files := make([]queuedFile, 0, len(entries))
+	var total int64
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		files = append(files, queuedFile{
+			path:    filepath.Join(q.dir, entry.Name()),
+			modTime: info.ModTime().UnixNano(),
+			size:    info.Size(),
+		})
+		total += info.Size()
+	}
+
+	sort.Slice(files, func(i, j int) bool { return files[i].modTime < files[j].modTime })
+
+	for len(files) > 0 && (len(files) > q.maxFiles || (q.maxSizeBytes > 0 && total > q.maxSizeBytes)) {
+		oldest := files[0]
+		if err := os.Remove(oldest.path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("telemetry: evicting %s: %w", oldest.path, err)
+		}
+		total -= oldest.size
+		files = files[1:]
+	}
+
+	return nil
+}
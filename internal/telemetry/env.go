@@ -0,0 +1,66 @@
+package telemetry
+
+import (
+	"os"
+	"strconv"
+)
+
+// Environment variable names telemetry reads, consistent with how the
+// crash-receiver ecosystem tools are configured.
+const (
+	EnvDir           = "HORIZCOIN_TELEMETRY_DIR"
+	EnvDSN           = "HORIZCOIN_TELEMETRY_DSN"
+	EnvMaxDiskFiles  = "HORIZCOIN_TELEMETRY_MAX_DISK_FILES"
+	EnvMaxDiskSizeMB = "HORIZCOIN_TELEMETRY_MAX_DISK_SIZE_MB"
+	EnvQueueSize     = "HORIZCOIN_TELEMETRY_QUEUE_SIZE"
+)
+
+const defaultDir = ".horizcoin/telemetry"
+
+// ConfigFromEnv builds a Config from the HORIZCOIN_TELEMETRY_* env
+// vars, falling back to DefaultConfig's bounds for anything unset.
+func ConfigFromEnv() Config {
+	cfg := DefaultConfig(defaultDir)
+
+	if v := os.Getenv(EnvDir); v != "" {
+		cfg.Dir = v
+	}
+	if v, ok := envInt(EnvMaxDiskFiles); ok {
+		cfg.MaxDiskFiles = v
+	}
+	if v, ok := envInt(EnvMaxDiskSizeMB); ok {
+		cfg.MaxDiskSizeMB = v
+	}
+	if v, ok := envInt(EnvQueueSize); ok {
+		cfg.QueueSize = v
+	}
+
+	return cfg
+}
+
+// SinkFromEnv builds the upload Sink HORIZCOIN_TELEMETRY_DSN selects:
+// an HTTPSink if it looks like a URL, a FileSink if it is a plain
+// path, and NoopSink if it is unset.
+func SinkFromEnv() Sink {
+	dsn := os.Getenv(EnvDSN)
+	switch {
+	case dsn == "":
+		return NoopSink{}
+	case len(dsn) >= 4 && (dsn[:4] == "http"):
+		return NewHTTPSink(dsn)
+	default:
+		return NewFileSink(dsn)
+	}
+}
+
+func envInt(name string) (int, bool) {
+	v := os.Getenv(name)
+	if v == "" {
+		return 0, false
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
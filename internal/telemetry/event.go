@@ -0,0 +1,32 @@
+// Package telemetry turns "Telemetry capture hooks TBD" into a real
+// subsystem: events are queued in memory, persisted to a
+// content-addressed disk queue bounded by count and size, and drained
+// to a pluggable upload sink in the background.
+package telemetry
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+)
+
+// Event is a single telemetry record: a demo run completing, a node
+// crashing, or anything else worth capturing for operator visibility.
+type Event struct {
+	Kind      string            `json:"kind"`
+	Timestamp int64             `json:"timestamp"`
+	Fields    map[string]string `json:"fields,omitempty"`
+	Stack     string            `json:"stack,omitempty"`
+}
+
+// contentHash returns the hex SHA-256 of the event's canonical JSON
+// encoding, used as its filename in the disk queue so identical events
+// never get written twice.
+func (e Event) contentHash() (string, []byte, error) {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return "", nil, err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), data, nil
+}
@@ -0,0 +1,28 @@
+package telemetry
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+// Guard runs fn, reporting any panic as a crash event (with the
+// panicking goroutine's stack) to reporter before re-panicking so the
+// process still exits the same way it would have without telemetry
+// instrumentation. recover() only catches a panic unwinding through
+// its own goroutine, so every goroutine that isn't already covered by
+// main's top-level recover (background endpoints, rotation loops,
+// ListenAndServe goroutines) needs to run through Guard to stay
+// visible to the crash reporter.
+func Guard(reporter *Reporter, fn func()) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			reporter.Report(Event{
+				Kind:   "crash",
+				Fields: map[string]string{"panic": fmt.Sprint(rec)},
+				Stack:  string(debug.Stack()),
+			})
+			panic(rec)
+		}
+	}()
+	fn()
+}
@@ -0,0 +1,125 @@
+package telemetry
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// Reporter accepts Events from the rest of the process, persists them
+// to a bounded disk queue, and drains them to sink in the background.
+type Reporter struct {
+	events chan Event
+	disk   *diskQueue
+	sink   Sink
+	done   chan struct{}
+}
+
+// Config bounds a Reporter's disk queue and in-memory send queue.
+type Config struct {
+	Dir           string
+	MaxDiskFiles  int
+	MaxDiskSizeMB int
+	QueueSize     int
+}
+
+// DefaultConfig returns sane bounds for a Reporter that has not been
+// given explicit sizes.
+func DefaultConfig(dir string) Config {
+	return Config{
+		Dir:           dir,
+		MaxDiskFiles:  1000,
+		MaxDiskSizeMB: 100,
+		QueueSize:     256,
+	}
+}
+
+// NewReporter starts a Reporter backed by sink. Call Close to stop the
+// background uploader and let in-flight sends finish.
+func NewReporter(cfg Config, sink Sink) (*Reporter, error) {
+	disk, err := newDiskQueue(cfg.Dir, cfg.MaxDiskFiles, cfg.MaxDiskSizeMB)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Reporter{
+		events: make(chan Event, cfg.QueueSize),
+		disk:   disk,
+		sink:   sink,
+		done:   make(chan struct{}),
+	}
+
+	go r.upload()
+
+	return r, nil
+}
+
+// Report persists e to disk immediately and queues it for upload. If
+// the in-memory send queue is full, the event is dropped from the
+// upload path (it has already been durably written to disk) rather
+// than blocking the caller.
+func (r *Reporter) Report(e Event) {
+	if e.Timestamp == 0 {
+		e.Timestamp = time.Now().Unix()
+	}
+
+	if err := r.disk.Write(e); err != nil {
+		log.Printf("telemetry: writing event to disk: %v", err)
+	}
+
+	select {
+	case r.events <- e:
+	default:
+		log.Printf("telemetry: upload queue full, dropping %s event from upload (still on disk)", e.Kind)
+	}
+}
+
+// Close stops accepting new events and waits for the uploader to drain
+// whatever is already queued.
+func (r *Reporter) Close() {
+	close(r.events)
+	<-r.done
+}
+
+// upload drains r.events to r.sink, retrying each event with
+// exponential backoff (capped) until it succeeds or maxSendAttempts
+// attempts have failed.
+func (r *Reporter) upload() {
+	defer close(r.done)
+
+	for e := range r.events {
+		r.sendWithBackoff(e)
+	}
+}
+
+// maxSendAttempts bounds how long a single stuck event can hold up the
+// uploader goroutine; after this many failures it is left on disk
+// (already durable) and the uploader moves on to the next event.
+const maxSendAttempts = 5
+
+func (r *Reporter) sendWithBackoff(e Event) {
+	backoff := 500 * time.Millisecond
+	const maxBackoff = 30 * time.Second
+
+	for attempt := 1; attempt <= maxSendAttempts; attempt++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		err := r.sink.Send(ctx, e)
+		cancel()
+		if err == nil {
+			return
+		}
+
+		if attempt == maxSendAttempts {
+			log.Printf("telemetry: sending %s event failed after %d attempts, giving up (still on disk): %v", e.Kind, attempt, err)
+			return
+		}
+
+		log.Printf("telemetry: sending %s event: %v (retrying in %s)", e.Kind, err, backoff)
+		time.Sleep(backoff)
+
+		backoff *= 2
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+)
+
+// Sink delivers a single Event somewhere off-box (or, for NoopSink,
+// nowhere). Implementations must be safe for concurrent use; the
+// uploader only ever calls Send from one goroutine at a time, but
+// callers are free to share a Sink elsewhere.
+type Sink interface {
+	Send(ctx context.Context, e Event) error
+}
+
+// NoopSink discards every event; it exists for tests and for running
+// with telemetry uploads disabled.
+type NoopSink struct{}
+
+func (NoopSink) Send(ctx context.Context, e Event) error { return nil }
+
+// FileSink appends each event as a JSON line to a single file, e.g. for
+// local development or piping telemetry into another log aggregator.
+type FileSink struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFileSink returns a FileSink appending to path.
+func NewFileSink(path string) *FileSink {
+	return &FileSink{path: path}
+}
+
+func (s *FileSink) Send(ctx context.Context, e Event) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("telemetry: encoding event: %w", err)
+	}
+	data = append(data, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("telemetry: opening %s: %w", s.path, err)
+	}
+	defer f.Close()
+
+	_, err = f.Write(data)
+	return err
+}
+
+// HTTPSink posts each event as JSON to a collector URL, consistent
+// with how the crash-receiver ecosystem tools are configured (a single
+// DSN-style endpoint that accepts a JSON body per event).
+type HTTPSink struct {
+	URL    string
+	Client *http.Client
+}
+
+// NewHTTPSink returns an HTTPSink posting to url with http.DefaultClient.
+func NewHTTPSink(url string) *HTTPSink {
+	return &HTTPSink{URL: url, Client: http.DefaultClient}
+}
+
+func (s *HTTPSink) Send(ctx context.Context, e Event) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("telemetry: encoding event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.URL, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("telemetry: building request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("telemetry: posting to %s: %w", s.URL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("telemetry: %s responded %s", s.URL, resp.Status)
+	}
+	return nil
+}
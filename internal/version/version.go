@@ -14,4 +14,17 @@ var (
 // String returns a human friendly version string.
 func String() string {
 	return fmt.Sprintf("v%s (%s by %s)", Version, Commit, BuiltBy)
+}
+
+// Info is the machine-readable form of the same build metadata that
+// String formats for humans.
+type Info struct {
+	Version string `json:"version"`
+	Commit  string `json:"commit"`
+	BuiltBy string `json:"built_by"`
+}
+
+// Current returns the build metadata as an Info value.
+func Current() Info {
+	return Info{Version: Version, Commit: Commit, BuiltBy: BuiltBy}
 }
\ No newline at end of file
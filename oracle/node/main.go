@@ -1,50 +1,49 @@
+// Command node runs the oracle as a standalone process. Under
+// `horizcoin serve`, the same pkg/oracle.Server runs as one endpoint
+// among several instead; this binary exists for running the oracle by
+// itself.
 package main
 
 import (
-	"encoding/json"
+	"context"
+	"encoding/hex"
 	"log"
-	"math/rand"
-	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
-)
-
-// Simple placeholder oracle node exposing a mock epoch report endpoint.
-
-type MockReport struct {
-	Epoch      uint64 `json:"epoch"`
-	Generated  int64  `json:"generated_at"`
-	TotalNodes int    `json:"total_nodes"`
-	MerkleRoot string `json:"merkle_root"`
-	Note       string `json:"note"`
-}
 
-var currentEpoch uint64 = 1
+	"github.com/thehorizonholding/HorizCoin/internal/telemetry"
+	"github.com/thehorizonholding/HorizCoin/pkg/oracle"
+)
 
 func main() {
-	mux := http.NewServeMux()
-	mux.HandleFunc("/health", func(w http.ResponseWriter, _ *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte("ok"))
-	})
-	mux.HandleFunc("/report", func(w http.ResponseWriter, _ *http.Request) {
-		report := MockReport{
-			Epoch:      currentEpoch,
-			Generated:  time.Now().Unix(),
-			TotalNodes: 100 + rand.Intn(20),
-			MerkleRoot: "0xDEADBEEF",
-			Note:       "Mock report - placeholder. Not cryptographically signed.",
+	reporter, err := telemetry.NewReporter(telemetry.ConfigFromEnv(), telemetry.SinkFromEnv())
+	if err != nil {
+		log.Printf("telemetry: disabled: %v", err)
+		reporter, err = telemetry.NewReporter(telemetry.Config{
+			Dir:           os.TempDir(),
+			MaxDiskFiles:  1,
+			MaxDiskSizeMB: 1,
+			QueueSize:     1,
+		}, telemetry.NoopSink{})
+		if err != nil {
+			log.Fatalf("telemetry: could not even start a no-op reporter: %v", err)
 		}
-		_ = json.NewEncoder(w).Encode(report)
-	})
+	}
+	defer reporter.Close()
 
-	go func() {
-		ticker := time.NewTicker(15 * time.Second)
-		for range ticker.C {
-			currentEpoch++
-		}
-	}()
+	server, pub, err := oracle.NewServerWithGeneratedKey(":8081", 15*time.Second, reporter)
+	if err != nil {
+		log.Fatalf("starting oracle server: %v", err)
+	}
+	log.Printf("oracle signing pubkey: %s", hex.EncodeToString(pub))
 
-	addr := ":8081"
-	log.Printf("Oracle mock node listening on %s", addr)
-	log.Fatal(http.ListenAndServe(addr, mux))
-} 
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	log.Printf("Oracle node listening on :8081")
+	if err := server.Start(ctx); err != nil {
+		log.Fatalf("oracle server: %v", err)
+	}
+}
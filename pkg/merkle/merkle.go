@@ -0,0 +1,152 @@
+// Package merkle implements a standard binary Merkle tree over SHA-256
+// leaf hashes, shared by every subsystem that needs to commit to a set
+// of records and later prove membership in it (oracle epoch reports,
+// genesis validator sets, and so on).
+package merkle
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+)
+
+// Tree is a binary Merkle tree built from an ordered list of leaves.
+// When the number of leaves at a level is odd, the last leaf is
+// duplicated so every level has an even width.
+type Tree struct {
+	levels [][][]byte // levels[0] is the leaf hashes, levels[len-1] is [root]
+}
+
+// Proof is an inclusion proof for a single leaf: the sibling hash at
+// each level from the leaf up to the root, plus which side the sibling
+// sits on (true if the sibling is the right-hand node).
+type Proof struct {
+	LeafIndex int
+	Siblings  [][]byte
+	RightSide []bool
+}
+
+// Domain-separation prefixes for leaf vs. internal-node hashes, as
+// recommended by RFC 6962 and similar Merkle tree schemes: without
+// them, an attacker-controlled leaf payload could be crafted to equal
+// the concatenation of two existing leaf hashes, producing a leaf that
+// collides with an internal node's hash (the classic second-preimage
+// weakness in naively-hashed Merkle trees).
+const (
+	leafPrefix     = 0x00
+	internalPrefix = 0x01
+)
+
+// HashLeaf returns the leaf hash for raw leaf data: SHA-256 of the leaf
+// domain prefix followed by the data itself. Callers are responsible
+// for canonically serializing the record before calling this, so the
+// same logical record always hashes to the same leaf.
+func HashLeaf(data []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{leafPrefix})
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+func hashPair(left, right []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{internalPrefix})
+	h.Write(left)
+	h.Write(right)
+	return h.Sum(nil)
+}
+
+// New builds a Tree from pre-hashed leaves. It returns an error if no
+// leaves are given.
+func New(leaves [][]byte) (*Tree, error) {
+	if len(leaves) == 0 {
+		return nil, fmt.Errorf("merkle: cannot build a tree with no leaves")
+	}
+
+	level := make([][]byte, len(leaves))
+	copy(level, leaves)
+
+	levels := [][][]byte{level}
+	for len(level) > 1 {
+		if len(level)%2 == 1 {
+			level = append(level, level[len(level)-1])
+		}
+		next := make([][]byte, 0, len(level)/2)
+		for i := 0; i < len(level); i += 2 {
+			next = append(next, hashPair(level[i], level[i+1]))
+		}
+		levels = append(levels, next)
+		level = next
+	}
+
+	return &Tree{levels: levels}, nil
+}
+
+// NewFromData hashes each item with HashLeaf and builds a Tree over the
+// resulting leaves.
+func NewFromData(items [][]byte) (*Tree, error) {
+	leaves := make([][]byte, len(items))
+	for i, item := range items {
+		leaves[i] = HashLeaf(item)
+	}
+	return New(leaves)
+}
+
+// Root returns the Merkle root.
+func (t *Tree) Root() []byte {
+	top := t.levels[len(t.levels)-1]
+	return top[0]
+}
+
+// LeafCount returns the number of leaves the tree was built from,
+// before odd-count padding.
+func (t *Tree) LeafCount() int {
+	return len(t.levels[0])
+}
+
+// Proof returns the inclusion proof for the leaf at leafIndex.
+func (t *Tree) Proof(leafIndex int) (*Proof, error) {
+	if leafIndex < 0 || leafIndex >= len(t.levels[0]) {
+		return nil, fmt.Errorf("merkle: leaf index %d out of range [0,%d)", leafIndex, len(t.levels[0]))
+	}
+
+	proof := &Proof{LeafIndex: leafIndex}
+	index := leafIndex
+	for _, level := range t.levels[:len(t.levels)-1] {
+		// A level may have been padded to even width during
+		// construction; reconstruct that padding here so the
+		// sibling lookup lines up with Root's view of the level.
+		padded := level
+		if len(padded)%2 == 1 {
+			padded = append(append([][]byte{}, padded...), padded[len(padded)-1])
+		}
+
+		isRight := index%2 == 1
+		var siblingIndex int
+		if isRight {
+			siblingIndex = index - 1
+		} else {
+			siblingIndex = index + 1
+		}
+
+		proof.Siblings = append(proof.Siblings, padded[siblingIndex])
+		proof.RightSide = append(proof.RightSide, !isRight)
+		index /= 2
+	}
+
+	return proof, nil
+}
+
+// Verify checks that leaf hashes to a leaf committed under root via the
+// given proof.
+func Verify(root []byte, leaf []byte, proof *Proof) bool {
+	current := leaf
+	for i, sibling := range proof.Siblings {
+		if proof.RightSide[i] {
+			current = hashPair(current, sibling)
+		} else {
+			current = hashPair(sibling, current)
+		}
+	}
+	return bytes.Equal(current, root)
+}
@@ -0,0 +1,90 @@
+package merkle
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestProofRoundTrip(t *testing.T) {
+	items := [][]byte{
+		[]byte("leaf-0"),
+		[]byte("leaf-1"),
+		[]byte("leaf-2"),
+		[]byte("leaf-3"),
+		[]byte("leaf-4"), // odd count forces last-leaf duplication
+	}
+
+	tree, err := NewFromData(items)
+	if err != nil {
+		t.Fatalf("NewFromData: %v", err)
+	}
+
+	root := tree.Root()
+	for i, item := range items {
+		leaf := HashLeaf(item)
+
+		proof, err := tree.Proof(i)
+		if err != nil {
+			t.Fatalf("Proof(%d): %v", i, err)
+		}
+		if proof.LeafIndex != i {
+			t.Errorf("Proof(%d).LeafIndex = %d, want %d", i, proof.LeafIndex, i)
+		}
+		if !Verify(root, leaf, proof) {
+			t.Errorf("Verify failed for leaf %d", i)
+		}
+	}
+}
+
+func TestVerifyRejectsWrongLeaf(t *testing.T) {
+	tree, err := NewFromData([][]byte{[]byte("a"), []byte("b"), []byte("c")})
+	if err != nil {
+		t.Fatalf("NewFromData: %v", err)
+	}
+
+	proof, err := tree.Proof(0)
+	if err != nil {
+		t.Fatalf("Proof: %v", err)
+	}
+
+	if Verify(tree.Root(), HashLeaf([]byte("not-a-leaf")), proof) {
+		t.Error("Verify accepted a proof for the wrong leaf")
+	}
+}
+
+func TestNewRejectsEmptyLeaves(t *testing.T) {
+	if _, err := New(nil); err == nil {
+		t.Error("New(nil) succeeded, want error")
+	}
+}
+
+func TestHashLeafDomainSeparatedFromHashPair(t *testing.T) {
+	// Without domain separation, hashing the concatenation of two leaf
+	// hashes as raw data would equal an internal node's hash; confirm
+	// that no longer holds now that leaf and internal-node hashing use
+	// distinct prefixes.
+	left := HashLeaf([]byte("a"))
+	right := HashLeaf([]byte("b"))
+
+	internal := hashPair(left, right)
+	forgedLeaf := HashLeaf(append(append([]byte{}, left...), right...))
+
+	if bytes.Equal(internal, forgedLeaf) {
+		t.Error("leaf hash of two concatenated leaf hashes collided with the internal node hash")
+	}
+}
+
+func TestRootDiffersByOrder(t *testing.T) {
+	treeA, err := NewFromData([][]byte{[]byte("a"), []byte("b")})
+	if err != nil {
+		t.Fatalf("NewFromData: %v", err)
+	}
+	treeB, err := NewFromData([][]byte{[]byte("b"), []byte("a")})
+	if err != nil {
+		t.Fatalf("NewFromData: %v", err)
+	}
+
+	if bytes.Equal(treeA.Root(), treeB.Root()) {
+		t.Error("trees with reordered leaves produced the same root")
+	}
+}
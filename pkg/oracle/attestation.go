@@ -0,0 +1,78 @@
+package oracle
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sort"
+)
+
+// NodeAttestation is the record an oracle node submits for a given
+// epoch: its identity, the epoch it is attesting to, how long it has
+// been up, whatever metrics it self-reports, and when it submitted.
+type NodeAttestation struct {
+	NodeID    string            `json:"node_id"`
+	Epoch     uint64            `json:"epoch"`
+	UptimeSec uint64            `json:"uptime_sec"`
+	Metrics   map[string]string `json:"metrics"`
+	Timestamp int64             `json:"timestamp"`
+}
+
+// Canonical returns a deterministic length-prefixed encoding of the
+// attestation suitable for leaf hashing: every string is written as an
+// 8-byte big-endian length followed by its bytes, every integer as an
+// 8-byte big-endian value, and the Metrics map
+// is sorted by key so the same logical attestation always serializes
+// to the same bytes regardless of map iteration order.
+func (a NodeAttestation) Canonical() []byte {
+	var buf bytes.Buffer
+
+	writeString(&buf, a.NodeID)
+	writeUint64(&buf, a.Epoch)
+	writeUint64(&buf, a.UptimeSec)
+
+	keys := make([]string, 0, len(a.Metrics))
+	for k := range a.Metrics {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	writeUint64(&buf, uint64(len(keys)))
+	for _, k := range keys {
+		writeString(&buf, k)
+		writeString(&buf, a.Metrics[k])
+	}
+
+	writeInt64(&buf, a.Timestamp)
+
+	return buf.Bytes()
+}
+
+// LeafHash returns the SHA-256 leaf hash used to commit this
+// attestation into an epoch's Merkle tree.
+func (a NodeAttestation) LeafHash() []byte {
+	return hashLeaf(a.Canonical())
+}
+
+func writeString(buf *bytes.Buffer, s string) {
+	writeUint64(buf, uint64(len(s)))
+	buf.WriteString(s)
+}
+
+func writeUint64(buf *bytes.Buffer, v uint64) {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], v)
+	buf.Write(b[:])
+}
+
+func writeInt64(buf *bytes.Buffer, v int64) {
+	writeUint64(buf, uint64(v))
+}
+
+// ErrNodeNotIncluded is returned when a proof is requested for a node
+// that did not submit an attestation in the given epoch.
+var ErrNodeNotIncluded = fmt.Errorf("oracle: node not included in epoch")
+
+// ErrEpochNotFound is returned when a report or proof is requested for
+// an epoch that has not been finalized yet.
+var ErrEpochNotFound = fmt.Errorf("oracle: epoch not found")
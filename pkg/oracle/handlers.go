@@ -0,0 +1,141 @@
+package oracle
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// simulateAttestations stands in for real node submissions until peer
+// collection is wired up; it produces a plausible, varying set of
+// attestations so the Merkle tree and proofs are exercised end to end.
+func simulateAttestations(epoch uint64) []NodeAttestation {
+	count := 100 + randIntn(20)
+	now := time.Now().Unix()
+	attestations := make([]NodeAttestation, count)
+	for i := 0; i < count; i++ {
+		attestations[i] = NodeAttestation{
+			NodeID:    "node-" + strconv.Itoa(i),
+			Epoch:     epoch,
+			UptimeSec: uint64(3600 + i),
+			Metrics:   map[string]string{"region": "sim"},
+			Timestamp: now,
+		}
+	}
+	return attestations
+}
+
+func randIntn(n int) int {
+	max := big.NewInt(int64(n))
+	v, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return 0
+	}
+	return int(v.Int64())
+}
+
+func (s *Server) handleHealth(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "only GET is supported")
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("ok"))
+}
+
+func (s *Server) handleLatestReport(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "only GET is supported")
+		return
+	}
+	report, ok := s.store.Latest()
+	if !ok {
+		writeError(w, http.StatusNotFound, "no_report", "no epoch has been finalized yet")
+		return
+	}
+	writeJSON(w, http.StatusOK, report)
+}
+
+func (s *Server) handleReportByEpoch(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "only GET is supported")
+		return
+	}
+	epoch, err := strconv.ParseUint(r.URL.Path[len("/report/"):], 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_epoch", "epoch must be a non-negative integer")
+		return
+	}
+	report, ok := s.store.Report(epoch)
+	if !ok {
+		writeError(w, http.StatusNotFound, "epoch_not_found", "no report has been finalized for that epoch")
+		return
+	}
+	writeJSON(w, http.StatusOK, report)
+}
+
+func (s *Server) handleProof(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "only GET is supported")
+		return
+	}
+
+	nodeID := r.URL.Query().Get("node_id")
+	epochParam := r.URL.Query().Get("epoch")
+	if nodeID == "" || epochParam == "" {
+		writeError(w, http.StatusBadRequest, "missing_params", "node_id and epoch query params are required")
+		return
+	}
+	epoch, err := strconv.ParseUint(epochParam, 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_epoch", "epoch must be a non-negative integer")
+		return
+	}
+
+	proof, leaf, err := s.store.Proof(epoch, nodeID)
+	switch {
+	case errors.Is(err, ErrEpochNotFound):
+		writeError(w, http.StatusNotFound, "epoch_not_found", "no report has been finalized for that epoch")
+		return
+	case errors.Is(err, ErrNodeNotIncluded):
+		writeError(w, http.StatusNotFound, "node_not_included", "node did not submit an attestation in that epoch")
+		return
+	case err != nil:
+		writeError(w, http.StatusInternalServerError, "internal_error", err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, struct {
+		NodeID    string   `json:"node_id"`
+		Epoch     uint64   `json:"epoch"`
+		LeafHash  []byte   `json:"leaf_hash"`
+		LeafIndex int      `json:"leaf_index"`
+		Siblings  [][]byte `json:"siblings"`
+		RightSide []bool   `json:"right_side"`
+	}{
+		NodeID:    nodeID,
+		Epoch:     epoch,
+		LeafHash:  leaf,
+		LeafIndex: proof.LeafIndex,
+		Siblings:  proof.Siblings,
+		RightSide: proof.RightSide,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, struct {
+		Error   bool   `json:"error"`
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}{Error: true, Code: code, Message: message})
+}
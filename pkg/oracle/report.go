@@ -0,0 +1,150 @@
+package oracle
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+
+	"github.com/thehorizonholding/HorizCoin/pkg/merkle"
+)
+
+// EpochReport is the signed, verifiable summary of an epoch's node
+// attestations: how many were collected, the Merkle root committing to
+// all of them, and a signature over that commitment. SignerScheme
+// records which algorithm produced Signature, so VerifyEpochReport
+// knows which one to check it with.
+type EpochReport struct {
+	Epoch        uint64 `json:"epoch"`
+	GeneratedAt  int64  `json:"generated_at"`
+	LeafCount    int    `json:"leaf_count"`
+	MerkleRoot   []byte `json:"merkle_root"`
+	Signature    []byte `json:"signature"`
+	SignerPubKey []byte `json:"signer_pub_key"`
+	SignerScheme string `json:"signer_scheme"`
+}
+
+// Scheme names a signature algorithm a Signer can report and
+// VerifyEpochReport can check. These mirror pkg/wallet's scheme names
+// so a wallet-backed Signer's Scheme() can be passed straight through.
+const (
+	SchemeEd25519   = "ed25519"
+	SchemeSecp256k1 = "secp256k1"
+)
+
+// Signer produces a signature over an arbitrary message and reports
+// which scheme it used, so VerifyEpochReport can check it with the
+// matching algorithm. A pkg/wallet.Signer satisfies this, which is the
+// intended signer for production reports; KeySigner below covers
+// standalone use and tests.
+type Signer interface {
+	Sign(msg []byte) (signature []byte, pubKey []byte, err error)
+	Scheme() string
+}
+
+// KeySigner signs with a raw in-memory Ed25519 private key. It exists
+// for tests and for standalone oracle nodes that have not yet been
+// wired up to pkg/wallet.
+type KeySigner struct {
+	PrivateKey ed25519.PrivateKey
+}
+
+func (k KeySigner) Sign(msg []byte) ([]byte, []byte, error) {
+	if len(k.PrivateKey) != ed25519.PrivateKeySize {
+		return nil, nil, fmt.Errorf("oracle: invalid ed25519 private key size")
+	}
+	sig := ed25519.Sign(k.PrivateKey, msg)
+	pub := k.PrivateKey.Public().(ed25519.PublicKey)
+	return sig, []byte(pub), nil
+}
+
+func (k KeySigner) Scheme() string { return SchemeEd25519 }
+
+func hashLeaf(data []byte) []byte {
+	return merkle.HashLeaf(data)
+}
+
+// epochReportMessage builds the message that gets signed:
+// hash(epoch || generated_at || leaf_count || merkle_root).
+func epochReportMessage(epoch uint64, generatedAt int64, leafCount int, root []byte) []byte {
+	var buf [8 + 8 + 8]byte
+	binary.BigEndian.PutUint64(buf[0:8], epoch)
+	binary.BigEndian.PutUint64(buf[8:16], uint64(generatedAt))
+	binary.BigEndian.PutUint64(buf[16:24], uint64(leafCount))
+
+	h := sha256.New()
+	h.Write(buf[:])
+	h.Write(root)
+	return h.Sum(nil)
+}
+
+// BuildEpochReport builds the Merkle tree over attestations, signs the
+// resulting commitment, and returns the signed EpochReport along with
+// the tree so callers (e.g. an HTTP handler serving /proof) can answer
+// inclusion-proof requests without rebuilding it.
+func BuildEpochReport(epoch uint64, attestations []NodeAttestation, signer Signer, now time.Time) (*EpochReport, *merkle.Tree, error) {
+	if len(attestations) == 0 {
+		return nil, nil, fmt.Errorf("oracle: cannot build an epoch report with no attestations")
+	}
+
+	leaves := make([][]byte, len(attestations))
+	for i, a := range attestations {
+		leaves[i] = a.LeafHash()
+	}
+
+	tree, err := merkle.New(leaves)
+	if err != nil {
+		return nil, nil, fmt.Errorf("oracle: building merkle tree: %w", err)
+	}
+
+	generatedAt := now.Unix()
+	root := tree.Root()
+	msg := epochReportMessage(epoch, generatedAt, tree.LeafCount(), root)
+
+	sig, pub, err := signer.Sign(msg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("oracle: signing epoch report: %w", err)
+	}
+
+	return &EpochReport{
+		Epoch:        epoch,
+		GeneratedAt:  generatedAt,
+		LeafCount:    tree.LeafCount(),
+		MerkleRoot:   root,
+		Signature:    sig,
+		SignerPubKey: pub,
+		SignerScheme: signer.Scheme(),
+	}, tree, nil
+}
+
+// VerifyEpochReport checks the report's signature over its own
+// commitment fields, using whichever scheme SignerScheme names. An
+// unrecognized or empty scheme fails closed rather than guessing.
+func VerifyEpochReport(r *EpochReport) bool {
+	msg := epochReportMessage(r.Epoch, r.GeneratedAt, r.LeafCount, r.MerkleRoot)
+
+	switch r.SignerScheme {
+	case SchemeEd25519:
+		if len(r.SignerPubKey) != ed25519.PublicKeySize || len(r.Signature) != ed25519.SignatureSize {
+			return false
+		}
+		return ed25519.Verify(r.SignerPubKey, msg, r.Signature)
+	case SchemeSecp256k1:
+		pub, err := btcec.ParsePubKey(r.SignerPubKey)
+		if err != nil {
+			return false
+		}
+		sig, err := ecdsa.ParseDERSignature(r.Signature)
+		if err != nil {
+			return false
+		}
+		digest := sha256.Sum256(msg)
+		return sig.Verify(digest[:], pub)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,100 @@
+package oracle
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+)
+
+func testAttestations(epoch uint64) []NodeAttestation {
+	return []NodeAttestation{
+		{NodeID: "node-1", Epoch: epoch, UptimeSec: 100, Timestamp: 1},
+		{NodeID: "node-2", Epoch: epoch, UptimeSec: 200, Timestamp: 2},
+	}
+}
+
+func TestBuildAndVerifyEpochReportEd25519(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("generating key: %v", err)
+	}
+	signer := KeySigner{PrivateKey: priv}
+
+	report, tree, err := BuildEpochReport(1, testAttestations(1), signer, time.Unix(1000, 0))
+	if err != nil {
+		t.Fatalf("BuildEpochReport: %v", err)
+	}
+	if tree.LeafCount() != 2 {
+		t.Fatalf("LeafCount = %d, want 2", tree.LeafCount())
+	}
+	if report.SignerScheme != SchemeEd25519 {
+		t.Fatalf("SignerScheme = %q, want %q", report.SignerScheme, SchemeEd25519)
+	}
+	if string(report.SignerPubKey) != string(pub) {
+		t.Fatalf("SignerPubKey mismatch")
+	}
+
+	if !VerifyEpochReport(report) {
+		t.Error("VerifyEpochReport rejected a validly signed report")
+	}
+
+	report.Signature[0] ^= 0xFF
+	if VerifyEpochReport(report) {
+		t.Error("VerifyEpochReport accepted a tampered signature")
+	}
+}
+
+// secp256k1Signer is a minimal oracle.Signer for secp256k1 keys, used
+// only to exercise VerifyEpochReport's scheme dispatch; production
+// callers get this from pkg/wallet.
+type secp256k1Signer struct {
+	priv *btcec.PrivateKey
+}
+
+func (s secp256k1Signer) Sign(msg []byte) ([]byte, []byte, error) {
+	digest := sha256.Sum256(msg)
+	sig := ecdsa.Sign(s.priv, digest[:])
+	return sig.Serialize(), s.priv.PubKey().SerializeCompressed(), nil
+}
+
+func (s secp256k1Signer) Scheme() string { return SchemeSecp256k1 }
+
+func TestBuildAndVerifyEpochReportSecp256k1(t *testing.T) {
+	priv, err := btcec.NewPrivateKey()
+	if err != nil {
+		t.Fatalf("generating key: %v", err)
+	}
+	signer := secp256k1Signer{priv: priv}
+
+	report, _, err := BuildEpochReport(1, testAttestations(1), signer, time.Unix(1000, 0))
+	if err != nil {
+		t.Fatalf("BuildEpochReport: %v", err)
+	}
+	if report.SignerScheme != SchemeSecp256k1 {
+		t.Fatalf("SignerScheme = %q, want %q", report.SignerScheme, SchemeSecp256k1)
+	}
+
+	if !VerifyEpochReport(report) {
+		t.Error("VerifyEpochReport rejected a validly signed secp256k1 report")
+	}
+}
+
+func TestVerifyEpochReportRejectsUnknownScheme(t *testing.T) {
+	_, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("generating key: %v", err)
+	}
+	report, _, err := BuildEpochReport(1, testAttestations(1), KeySigner{PrivateKey: priv}, time.Unix(1000, 0))
+	if err != nil {
+		t.Fatalf("BuildEpochReport: %v", err)
+	}
+
+	report.SignerScheme = "unknown"
+	if VerifyEpochReport(report) {
+		t.Error("VerifyEpochReport accepted an unrecognized scheme")
+	}
+}
@@ -0,0 +1,109 @@
+package oracle
+
+import (
+	"sync"
+	"time"
+
+	"github.com/thehorizonholding/HorizCoin/pkg/merkle"
+)
+
+// epochRecord bundles a signed report with enough bookkeeping to answer
+// proof requests for the attestations it was built from.
+type epochRecord struct {
+	report       *EpochReport
+	tree         *merkle.Tree
+	nodeIndex    map[string]int
+	attestations []NodeAttestation
+}
+
+// Store holds finalized epoch reports in memory, keyed by epoch
+// number. It is safe for concurrent use by HTTP handlers.
+type Store struct {
+	mu     sync.RWMutex
+	epochs map[uint64]*epochRecord
+	signer Signer
+}
+
+// NewStore returns an empty Store that signs new epoch reports with
+// signer.
+func NewStore(signer Signer) *Store {
+	return &Store{
+		epochs: make(map[uint64]*epochRecord),
+		signer: signer,
+	}
+}
+
+// Finalize builds, signs, and stores the epoch report for the given
+// attestations, replacing any previously finalized report for that
+// epoch.
+func (s *Store) Finalize(epoch uint64, attestations []NodeAttestation) (*EpochReport, error) {
+	report, tree, err := BuildEpochReport(epoch, attestations, s.signer, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	index := make(map[string]int, len(attestations))
+	for i, a := range attestations {
+		index[a.NodeID] = i
+	}
+
+	s.mu.Lock()
+	s.epochs[epoch] = &epochRecord{
+		report:       report,
+		tree:         tree,
+		nodeIndex:    index,
+		attestations: attestations,
+	}
+	s.mu.Unlock()
+
+	return report, nil
+}
+
+// Latest returns the most recently finalized report, if any.
+func (s *Store) Latest() (*EpochReport, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var latest *EpochReport
+	for _, rec := range s.epochs {
+		if latest == nil || rec.report.Epoch > latest.Epoch {
+			latest = rec.report
+		}
+	}
+	return latest, latest != nil
+}
+
+// Report returns the finalized report for epoch, if any.
+func (s *Store) Report(epoch uint64) (*EpochReport, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rec, ok := s.epochs[epoch]
+	if !ok {
+		return nil, false
+	}
+	return rec.report, true
+}
+
+// Proof returns the inclusion proof and leaf hash for nodeID's
+// attestation in epoch.
+func (s *Store) Proof(epoch uint64, nodeID string) (*merkle.Proof, []byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rec, ok := s.epochs[epoch]
+	if !ok {
+		return nil, nil, ErrEpochNotFound
+	}
+
+	idx, ok := rec.nodeIndex[nodeID]
+	if !ok {
+		return nil, nil, ErrNodeNotIncluded
+	}
+
+	proof, err := rec.tree.Proof(idx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return proof, rec.attestations[idx].LeafHash(), nil
+}
@@ -0,0 +1,105 @@
+package wallet
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// Scrypt parameters for deriving the AES-256-GCM key that encrypts a
+// private key on disk. N/r/p are the interactive-login values
+// (N=32768, r=8, p=1) recommended by golang.org/x/crypto/scrypt.
+const (
+	scryptN      = 1 << 15
+	scryptR      = 8
+	scryptP      = 1
+	scryptKeyLen = 32
+	saltLen      = 16
+)
+
+// keyFile is the on-disk JSON representation of one key: the public
+// parts in the clear, the private key behind scrypt + AES-GCM.
+type keyFile struct {
+	Name   string `json:"name"`
+	Scheme Scheme `json:"scheme"`
+	PubKey []byte `json:"pub_key"`
+
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+func sealKeyFile(name string, scheme Scheme, pub, priv []byte, passphrase string) (*keyFile, error) {
+	salt := make([]byte, saltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("wallet: generating salt: %w", err)
+	}
+
+	aead, err := newAEAD(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("wallet: generating nonce: %w", err)
+	}
+
+	ciphertext := aead.Seal(nil, nonce, priv, nil)
+
+	return &keyFile{
+		Name:       name,
+		Scheme:     scheme,
+		PubKey:     pub,
+		Salt:       salt,
+		Nonce:      nonce,
+		Ciphertext: ciphertext,
+	}, nil
+}
+
+func (kf *keyFile) unseal(passphrase string) ([]byte, error) {
+	aead, err := newAEAD(passphrase, kf.Salt)
+	if err != nil {
+		return nil, err
+	}
+
+	priv, err := aead.Open(nil, kf.Nonce, kf.Ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("wallet: wrong passphrase or corrupted key file: %w", err)
+	}
+	return priv, nil
+}
+
+func newAEAD(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, scryptN, scryptR, scryptP, scryptKeyLen)
+	if err != nil {
+		return nil, fmt.Errorf("wallet: deriving key: %w", err)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("wallet: building cipher: %w", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("wallet: building AEAD: %w", err)
+	}
+	return aead, nil
+}
+
+func (kf *keyFile) marshal() ([]byte, error) {
+	return json.MarshalIndent(kf, "", "  ")
+}
+
+func unmarshalKeyFile(data []byte) (*keyFile, error) {
+	var kf keyFile
+	if err := json.Unmarshal(data, &kf); err != nil {
+		return nil, fmt.Errorf("wallet: parsing key file: %w", err)
+	}
+	return &kf, nil
+}
@@ -0,0 +1,102 @@
+// Package wallet is HorizCoin's single canonical key store: keys are
+// generated, listed, shown, deleted, renamed-password, and used to
+// sign messages through one Wallet, backed by scrypt-encrypted JSON
+// files on disk. The oracle's report signer consumes Wallet.Sign
+// through a Signer when its horizcoin.yaml endpoint names a
+// signer_key; without one, and in the standalone oracle/node binary,
+// it signs with a freshly generated in-memory Ed25519 key that is not
+// stored in the wallet.
+package wallet
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+)
+
+// Scheme identifies which signature algorithm a key uses.
+type Scheme string
+
+const (
+	SchemeEd25519   Scheme = "ed25519"
+	SchemeSecp256k1 Scheme = "secp256k1"
+)
+
+// keyScheme is the internal per-algorithm implementation a Scheme
+// resolves to.
+type keyScheme interface {
+	generate() (priv, pub []byte, err error)
+	sign(priv, msg []byte) ([]byte, error)
+	verify(pub, msg, sig []byte) bool
+}
+
+func schemeImpl(s Scheme) (keyScheme, error) {
+	switch s {
+	case SchemeEd25519:
+		return ed25519Scheme{}, nil
+	case SchemeSecp256k1:
+		return secp256k1Scheme{}, nil
+	default:
+		return nil, fmt.Errorf("wallet: unsupported key scheme %q", s)
+	}
+}
+
+type ed25519Scheme struct{}
+
+func (ed25519Scheme) generate() ([]byte, []byte, error) {
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("wallet: generating ed25519 key: %w", err)
+	}
+	return priv, pub, nil
+}
+
+func (ed25519Scheme) sign(priv, msg []byte) ([]byte, error) {
+	if len(priv) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("wallet: invalid ed25519 private key size")
+	}
+	return ed25519.Sign(ed25519.PrivateKey(priv), msg), nil
+}
+
+func (ed25519Scheme) verify(pub, msg, sig []byte) bool {
+	if len(pub) != ed25519.PublicKeySize {
+		return false
+	}
+	return ed25519.Verify(ed25519.PublicKey(pub), msg, sig)
+}
+
+// secp256k1Scheme signs the SHA-256 digest of msg with ECDSA over
+// secp256k1, matching how the rest of the ecosystem signs
+// transactions with this curve.
+type secp256k1Scheme struct{}
+
+func (secp256k1Scheme) generate() ([]byte, []byte, error) {
+	priv, err := btcec.NewPrivateKey()
+	if err != nil {
+		return nil, nil, fmt.Errorf("wallet: generating secp256k1 key: %w", err)
+	}
+	return priv.Serialize(), priv.PubKey().SerializeCompressed(), nil
+}
+
+func (secp256k1Scheme) sign(priv, msg []byte) ([]byte, error) {
+	key, _ := btcec.PrivKeyFromBytes(priv)
+	digest := sha256.Sum256(msg)
+	sig := ecdsa.Sign(key, digest[:])
+	return sig.Serialize(), nil
+}
+
+func (secp256k1Scheme) verify(pub, msg, sig []byte) bool {
+	key, err := btcec.ParsePubKey(pub)
+	if err != nil {
+		return false
+	}
+	parsedSig, err := ecdsa.ParseDERSignature(sig)
+	if err != nil {
+		return false
+	}
+	digest := sha256.Sum256(msg)
+	return parsedSig.Verify(digest[:], key)
+}
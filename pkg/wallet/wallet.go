@@ -0,0 +1,266 @@
+package wallet
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// KeyInfo is the public information about a stored key.
+type KeyInfo struct {
+	Name   string
+	Scheme Scheme
+	PubKey []byte
+}
+
+// Signature is the result of Wallet.Sign: the bytes needed to verify
+// it without consulting the wallet again.
+type Signature struct {
+	Scheme Scheme
+	PubKey []byte
+	Bytes  []byte
+}
+
+// Wallet is a directory of scrypt-encrypted key files.
+type Wallet struct {
+	dir string
+}
+
+// DefaultDir returns ~/.horizcoin/keys, the default key store location.
+func DefaultDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("wallet: resolving home directory: %w", err)
+	}
+	return filepath.Join(home, ".horizcoin", "keys"), nil
+}
+
+// New returns a Wallet backed by dir, creating it if it does not
+// already exist.
+func New(dir string) (*Wallet, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("wallet: creating key directory %s: %w", dir, err)
+	}
+	return &Wallet{dir: dir}, nil
+}
+
+// validateName rejects key names that don't round-trip through
+// filepath.Base, so a name like "../../somewhere/evil" passed through
+// the "keys add/show/delete/update" CLI can't escape w.dir.
+func validateName(name string) error {
+	if name == "" || name != filepath.Base(name) || name == "." || name == ".." {
+		return fmt.Errorf("wallet: invalid key name %q", name)
+	}
+	return nil
+}
+
+func (w *Wallet) path(name string) string {
+	return filepath.Join(w.dir, name+".json")
+}
+
+// NewKey generates a key of the given scheme, encrypts it with
+// passphrase, and stores it under name. It returns an error if a key
+// with that name already exists.
+func (w *Wallet) NewKey(name string, scheme Scheme, passphrase string) (KeyInfo, error) {
+	if err := validateName(name); err != nil {
+		return KeyInfo{}, err
+	}
+	if _, err := os.Stat(w.path(name)); err == nil {
+		return KeyInfo{}, fmt.Errorf("wallet: key %q already exists", name)
+	}
+
+	impl, err := schemeImpl(scheme)
+	if err != nil {
+		return KeyInfo{}, err
+	}
+
+	priv, pub, err := impl.generate()
+	if err != nil {
+		return KeyInfo{}, err
+	}
+
+	kf, err := sealKeyFile(name, scheme, pub, priv, passphrase)
+	if err != nil {
+		return KeyInfo{}, err
+	}
+
+	if err := w.writeKeyFile(name, kf); err != nil {
+		return KeyInfo{}, err
+	}
+
+	return KeyInfo{Name: name, Scheme: scheme, PubKey: pub}, nil
+}
+
+// List returns every stored key's public info, sorted by name.
+func (w *Wallet) List() ([]KeyInfo, error) {
+	entries, err := os.ReadDir(w.dir)
+	if err != nil {
+		return nil, fmt.Errorf("wallet: listing %s: %w", w.dir, err)
+	}
+
+	var infos []KeyInfo
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+		name := strings.TrimSuffix(entry.Name(), ".json")
+		info, err := w.Show(name)
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, info)
+	}
+
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+	return infos, nil
+}
+
+// Show returns the public info for the key named name.
+func (w *Wallet) Show(name string) (KeyInfo, error) {
+	kf, err := w.readKeyFile(name)
+	if err != nil {
+		return KeyInfo{}, err
+	}
+	return KeyInfo{Name: kf.Name, Scheme: kf.Scheme, PubKey: kf.PubKey}, nil
+}
+
+// Delete removes the key named name.
+func (w *Wallet) Delete(name string) error {
+	if err := validateName(name); err != nil {
+		return err
+	}
+	if err := os.Remove(w.path(name)); err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("wallet: key %q not found", name)
+		}
+		return fmt.Errorf("wallet: deleting key %q: %w", name, err)
+	}
+	return nil
+}
+
+// Update re-encrypts the key named name under newPass, after
+// confirming oldPass decrypts it.
+func (w *Wallet) Update(name, oldPass, newPass string) error {
+	kf, err := w.readKeyFile(name)
+	if err != nil {
+		return err
+	}
+
+	priv, err := kf.unseal(oldPass)
+	if err != nil {
+		return err
+	}
+
+	newKf, err := sealKeyFile(kf.Name, kf.Scheme, kf.PubKey, priv, newPass)
+	if err != nil {
+		return err
+	}
+
+	return w.writeKeyFile(name, newKf)
+}
+
+// Sign decrypts the key named name with passphrase and signs msg with
+// it.
+func (w *Wallet) Sign(name, passphrase string, msg []byte) (Signature, error) {
+	kf, err := w.readKeyFile(name)
+	if err != nil {
+		return Signature{}, err
+	}
+
+	priv, err := kf.unseal(passphrase)
+	if err != nil {
+		return Signature{}, err
+	}
+
+	impl, err := schemeImpl(kf.Scheme)
+	if err != nil {
+		return Signature{}, err
+	}
+
+	sigBytes, err := impl.sign(priv, msg)
+	if err != nil {
+		return Signature{}, err
+	}
+
+	return Signature{Scheme: kf.Scheme, PubKey: kf.PubKey, Bytes: sigBytes}, nil
+}
+
+// Signer is a handle bound to one stored key that can sign on demand.
+// Its Sign method intentionally matches the shape consumers like
+// pkg/oracle's report signing expect (sig, pubkey, err), so those
+// packages can accept it without importing pkg/wallet's types; Scheme
+// lets them pick a matching verification algorithm the same way.
+type Signer struct {
+	wallet     *Wallet
+	name       string
+	passphrase string
+	scheme     Scheme
+}
+
+// Signer returns a Signer bound to the named key, so callers do not
+// need to pass a passphrase on every Sign call. It reads the key's
+// scheme from its file up front (without decrypting it) so Scheme()
+// is available before the first Sign.
+func (w *Wallet) Signer(name, passphrase string) (Signer, error) {
+	kf, err := w.readKeyFile(name)
+	if err != nil {
+		return Signer{}, err
+	}
+	return Signer{wallet: w, name: name, passphrase: passphrase, scheme: kf.Scheme}, nil
+}
+
+// Sign decrypts the bound key and signs msg with it.
+func (s Signer) Sign(msg []byte) (signature []byte, pubKey []byte, err error) {
+	sig, err := s.wallet.Sign(s.name, s.passphrase, msg)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sig.Bytes, sig.PubKey, nil
+}
+
+// Scheme reports which signature algorithm this Signer's key uses.
+func (s Signer) Scheme() string {
+	return string(s.scheme)
+}
+
+// Verify checks that sig is a valid signature over msg by pubKey,
+// using the signature scheme embedded in sig. It is a free function
+// rather than a Wallet method because verification needs no key-store
+// state: anyone holding a public key and a signature can check it.
+func Verify(pubKey, msg []byte, sig Signature) bool {
+	impl, err := schemeImpl(sig.Scheme)
+	if err != nil {
+		return false
+	}
+	return impl.verify(pubKey, msg, sig.Bytes)
+}
+
+func (w *Wallet) writeKeyFile(name string, kf *keyFile) error {
+	if err := validateName(name); err != nil {
+		return err
+	}
+	data, err := kf.marshal()
+	if err != nil {
+		return fmt.Errorf("wallet: encoding key %q: %w", name, err)
+	}
+	if err := os.WriteFile(w.path(name), data, 0o600); err != nil {
+		return fmt.Errorf("wallet: writing key %q: %w", name, err)
+	}
+	return nil
+}
+
+func (w *Wallet) readKeyFile(name string) (*keyFile, error) {
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(w.path(name))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("wallet: key %q not found", name)
+		}
+		return nil, fmt.Errorf("wallet: reading key %q: %w", name, err)
+	}
+	return unmarshalKeyFile(data)
+}
@@ -0,0 +1,139 @@
+package wallet
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNewKeyRoundTripsThroughSignAndVerify(t *testing.T) {
+	for _, scheme := range []Scheme{SchemeEd25519, SchemeSecp256k1} {
+		scheme := scheme
+		t.Run(string(scheme), func(t *testing.T) {
+			w, err := New(t.TempDir())
+			if err != nil {
+				t.Fatalf("New: %v", err)
+			}
+
+			info, err := w.NewKey("alice", scheme, "correct horse battery staple")
+			if err != nil {
+				t.Fatalf("NewKey: %v", err)
+			}
+			if info.Scheme != scheme {
+				t.Fatalf("info.Scheme = %q, want %q", info.Scheme, scheme)
+			}
+
+			msg := []byte("sign me")
+			sig, err := w.Sign("alice", "correct horse battery staple", msg)
+			if err != nil {
+				t.Fatalf("Sign: %v", err)
+			}
+			if !Verify(info.PubKey, msg, sig) {
+				t.Error("Verify rejected a signature from the key it was generated with")
+			}
+
+			if _, err := w.Sign("alice", "wrong passphrase", msg); err == nil {
+				t.Error("Sign succeeded with the wrong passphrase")
+			}
+		})
+	}
+}
+
+func TestSignerScheme(t *testing.T) {
+	w, err := New(t.TempDir())
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if _, err := w.NewKey("oracle-key", SchemeSecp256k1, "pw"); err != nil {
+		t.Fatalf("NewKey: %v", err)
+	}
+
+	signer, err := w.Signer("oracle-key", "pw")
+	if err != nil {
+		t.Fatalf("Signer: %v", err)
+	}
+	if signer.Scheme() != string(SchemeSecp256k1) {
+		t.Errorf("Scheme() = %q, want %q", signer.Scheme(), SchemeSecp256k1)
+	}
+
+	sig, pub, err := signer.Sign([]byte("msg"))
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+	if !Verify(pub, []byte("msg"), Signature{Scheme: SchemeSecp256k1, PubKey: pub, Bytes: sig}) {
+		t.Error("signer-produced signature failed verification")
+	}
+}
+
+func TestUpdateReencryptsUnderNewPassphrase(t *testing.T) {
+	w, err := New(t.TempDir())
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if _, err := w.NewKey("bob", SchemeEd25519, "old-pass"); err != nil {
+		t.Fatalf("NewKey: %v", err)
+	}
+
+	if err := w.Update("bob", "old-pass", "new-pass"); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+
+	if _, err := w.Sign("bob", "old-pass", []byte("msg")); err == nil {
+		t.Error("Sign succeeded with the passphrase Update was supposed to replace")
+	}
+	if _, err := w.Sign("bob", "new-pass", []byte("msg")); err != nil {
+		t.Errorf("Sign with the new passphrase failed: %v", err)
+	}
+}
+
+func TestNewKeyRejectsPathEscapingNames(t *testing.T) {
+	dir := t.TempDir()
+	w, err := New(dir)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	for _, name := range []string{"../escaped", "../../escaped", "a/b", "/etc/passwd", "", ".", ".."} {
+		if _, err := w.NewKey(name, SchemeEd25519, "pw"); err == nil {
+			t.Errorf("NewKey(%q) succeeded, want error", name)
+		}
+	}
+
+	if _, err := os.Stat(filepath.Join(filepath.Dir(dir), "escaped.json")); !os.IsNotExist(err) {
+		t.Error("NewKey escaped the wallet directory")
+	}
+
+	for _, fn := range []func(string) error{
+		func(name string) error { _, err := w.Show(name); return err },
+		func(name string) error { return w.Delete(name) },
+		func(name string) error { return w.Update(name, "old", "new") },
+		func(name string) error { _, err := w.Signer(name, "pw"); return err },
+	} {
+		if err := fn("../escaped"); err == nil {
+			t.Error("wallet method accepted a path-escaping name")
+		}
+	}
+}
+
+func TestSealUnsealKeyFileRoundTrip(t *testing.T) {
+	priv := []byte("not a real private key, just seal/unseal bytes")
+	pub := []byte("not a real public key")
+
+	kf, err := sealKeyFile("carol", SchemeEd25519, pub, priv, "passphrase")
+	if err != nil {
+		t.Fatalf("sealKeyFile: %v", err)
+	}
+
+	got, err := kf.unseal("passphrase")
+	if err != nil {
+		t.Fatalf("unseal: %v", err)
+	}
+	if !bytes.Equal(got, priv) {
+		t.Errorf("unsealed private key = %q, want %q", got, priv)
+	}
+
+	if _, err := kf.unseal("wrong"); err == nil {
+		t.Error("unseal succeeded with the wrong passphrase")
+	}
+}